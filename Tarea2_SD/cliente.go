@@ -2,16 +2,22 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
-	pb "Tarea2_SD/emergencia"
+	pbv2 "Tarea2_SD/emergencia/v2"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 type Emergencia struct {
@@ -42,25 +48,307 @@ func cargarEmergencias(path string) ([]Emergencia, error) {
 	return emergencias, nil
 }
 
-// containsExtinguido verifica si un mensaje contiene la cadena "ha sido extinguido"
+// seguimiento reconoce cuándo terminó cada emergencia enviada por este cliente, cruzando
+// los IDs recibidos en los acuses con los eventos de extinción o cancelación del
+// monitoreo, que pueden llegar en cualquier orden
+type seguimiento struct {
+	mu         sync.Mutex
+	propias    map[int32]bool
+	terminadas map[int32]bool
+	listas     chan int32
+}
+
+// nuevoSeguimiento crea un seguimiento para n emergencias
 //
-// Parámetros: msg string: Mensaje a verificar
+// Parámetros: n int: Cantidad de emergencias enviadas
+//
+// Retorna: *seguimiento: Seguimiento vacío
+func nuevoSeguimiento(n int) *seguimiento {
+	return &seguimiento{
+		propias:    make(map[int32]bool),
+		terminadas: make(map[int32]bool),
+		listas:     make(chan int32, n),
+	}
+}
+
+// propia registra el ID de una emergencia enviada por este cliente
 //
-// Retorna: bool: true si el mensaje contiene la cadena, false en caso contrario
-func containsExtinguido(msg string) bool {
-	return strings.Contains(msg, "ha sido extinguido")
+// Parámetros: id int32: ID recibido en un acuse
+func (sg *seguimiento) propia(id int32) {
+	sg.marcar(id, sg.propias, sg.terminadas)
+}
+
+// terminada registra que una emergencia, de este u otro cliente, se extinguió o se canceló
+//
+// Parámetros: id int32: ID del evento terminal
+func (sg *seguimiento) terminada(id int32) {
+	sg.marcar(id, sg.terminadas, sg.propias)
+}
+
+// marcar agrega id a destino y, si ya estaba en otro, avisa por listas que esa emergencia
+// propia terminó
+func (sg *seguimiento) marcar(id int32, destino, otro map[int32]bool) {
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+	if destino[id] {
+		return
+	}
+	destino[id] = true
+	if otro[id] {
+		sg.listas <- id
+	}
+}
+
+// Reintentos del envío de emergencias si el stream con el asignador se corta
+const (
+	maxIntentosEnvio = 5
+	esperaReintento  = 2 * time.Second
+)
+
+// nuevasClaves genera una clave de idempotencia distinta para cada emergencia, que se
+// mantiene en todos los reintentos para que el asignador no las registre dos veces
+//
+// Parámetros: n int: Cantidad de emergencias
+//
+// Retorna: []string: Una clave por emergencia
+func nuevasClaves(n int) []string {
+	prefijo := make([]byte, 8)
+	if _, err := rand.Read(prefijo); err != nil {
+		log.Fatalf("Error generando claves de idempotencia: %v", err)
+	}
+	claves := make([]string, n)
+	for i := range claves {
+		claves[i] = fmt.Sprintf("%s-%d", hex.EncodeToString(prefijo), i)
+	}
+	return claves
+}
+
+// seguirMonitoreo muestra los mensajes del stream de monitoreo y registra las emergencias
+// terminadas, hasta que ctx se cancele
+//
+// Si el stream se corta, se reconecta pidiendo la secuencia siguiente a la última recibida,
+// junto con su época, de modo que no se pierden ni se repiten eventos. Si el servicio de
+// monitoreo se reinició, la época ya no coincide y el servicio responde con un aviso de
+// reinicio y los mensajes que retiene desde entonces.
+//
+// Parámetros: ctx context.Context: Contexto del stream; monitoreo pbv2.MonitoreoClient:
+// Cliente del servicio de monitoreo; stream pbv2.Monitoreo_StreamMensajesClient: Stream ya
+// abierto; sg *seguimiento: Donde se registran las emergencias terminadas
+func seguirMonitoreo(ctx context.Context, monitoreo pbv2.MonitoreoClient, stream pbv2.Monitoreo_StreamMensajesClient, sg *seguimiento) {
+	var ultima uint64
+	var epoca string
+	for {
+		msg, err := stream.Recv()
+		if err == nil {
+			if msg.Secuencia > 0 {
+				ultima, epoca = msg.Secuencia, msg.Epoca
+			}
+			if msg.GetSalto().GetReinicio() {
+				log.Printf("El servicio de monitoreo se reinició; pudieron perderse eventos posteriores a la secuencia %d", ultima)
+			}
+			fmt.Println(msg.Contenido)
+			if msg.Evento != nil && msg.Evento.Terminal() {
+				sg.terminada(msg.Evento.EmergencyId)
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Printf("Stream de monitoreo cortado (%v), reconectando...", err)
+		sus := &pbv2.SuscripcionMonitoreo{Modo: pbv2.ModoEntrega_ENTREGA_TIEMPO_REAL}
+		if ultima > 0 {
+			sus.Inicio = &pbv2.SuscripcionMonitoreo_DesdeSecuencia{DesdeSecuencia: ultima + 1}
+			sus.Epoca = epoca
+		}
+		for {
+			time.Sleep(esperaReintento)
+			if ctx.Err() != nil {
+				return
+			}
+			if stream, err = monitoreo.StreamMensajes(ctx, sus); err == nil {
+				break
+			}
+		}
+	}
+}
+
+// enviarEmergencias envía las emergencias por un stream al servicio de asignación y
+// muestra el acuse de cada una, hasta que el asignador cierra el stream
+//
+// Parámetros: client pbv2.AsignadorClient: Cliente del asignador; emergencias []Emergencia:
+// Emergencias a enviar; claves []string: Clave de idempotencia de cada emergencia;
+// sg *seguimiento: Donde se registra el ID de cada emergencia aceptada
+//
+// Retorna: error: Si el stream falla antes de recibir todos los acuses
+func enviarEmergencias(client pbv2.AsignadorClient, emergencias []Emergencia, claves []string, sg *seguimiento) error {
+	envio, err := client.EnviarEmergenciasStream(context.Background())
+	if err != nil {
+		return err
+	}
+
+	recibido := make(chan error, 1)
+	go func() {
+		for {
+			acuse, err := envio.Recv()
+			if err == io.EOF {
+				recibido <- nil
+				return
+			}
+			if err != nil {
+				recibido <- err
+				return
+			}
+			nombre := emergencias[acuse.Indice].Name
+			sg.propia(acuse.EmergencyId)
+			switch acuse.Tipo {
+			case pbv2.TipoAcuse_ACUSE_DUPLICADA:
+				fmt.Printf("Emergencia %d (%s) ya registrada: %s\n", acuse.EmergencyId, nombre, acuse.Detalle)
+			case pbv2.TipoAcuse_ACUSE_ASIGNADA:
+				fmt.Printf("Emergencia %d (%s) asignada a %s\n", acuse.EmergencyId, nombre, acuse.DronId)
+			case pbv2.TipoAcuse_ACUSE_CANCELADA:
+				fmt.Printf("Emergencia %d (%s) cancelada\n", acuse.EmergencyId, nombre)
+			default:
+				fmt.Printf("Emergencia %d (%s) en cola, posición %d\n", acuse.EmergencyId, nombre, acuse.Posicion)
+			}
+		}
+	}()
+
+	for i, e := range emergencias {
+		fmt.Printf("\nEmergencia enviada : %s magnitud %d en x=%d , y=%d\n", e.Name, e.Magnitude, int(e.Latitude), int(e.Longitude))
+
+		err := envio.Send(&pbv2.Emergencia{
+			Name:              e.Name,
+			Latitude:          e.Latitude,
+			Longitude:         e.Longitude,
+			Magnitude:         int32(e.Magnitude),
+			ClaveIdempotencia: claves[i],
+		})
+		if err != nil {
+			// El motivo real del corte llega por Recv
+			break
+		}
+	}
+	envio.CloseSend()
+	return <-recibido
+}
+
+// cancelar solicita al servicio de asignación cancelar una emergencia
+//
+// Parámetros: id string: ID de la emergencia; motivo string: Motivo de la cancelación
+func cancelar(id, motivo string) {
+	emergencyID, err := strconv.Atoi(id)
+	if err != nil {
+		log.Fatalf("ID de emergencia inválido: %s", id)
+	}
+
+	conn, err := grpc.Dial("10.10.28.57:50051", grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("No se pudo conectar al servicio de asignación: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := pbv2.NewAsignadorClient(conn).CancelarEmergencia(context.Background(), &pbv2.CancelarEmergenciaRequest{
+		EmergencyId: int32(emergencyID),
+		Motivo:      motivo,
+	})
+	if err != nil {
+		log.Fatalf("Error al cancelar emergencia: %v", err)
+	}
+	if resp.DronId != "" {
+		fmt.Printf("Misión de %s abortada para la emergencia %d\n", resp.DronId, resp.EmergencyId)
+	} else {
+		fmt.Printf("Emergencia %d retirada de la cola\n", resp.EmergencyId)
+	}
+}
+
+// actualizar solicita al servicio de asignación cambiar la magnitud o la ubicación de una
+// emergencia pendiente o en curso
+//
+// Parámetros: id string: ID de la emergencia; cambios []string: Cambios de la forma
+// magnitud=N, ubicacion=lat,long o refuerzos=N
+func actualizar(id string, cambios []string) {
+	emergencyID, err := strconv.Atoi(id)
+	if err != nil {
+		log.Fatalf("ID de emergencia inválido: %s", id)
+	}
+	req := &pbv2.ActualizarEmergenciaRequest{EmergencyId: int32(emergencyID)}
+	for _, c := range cambios {
+		clave, valor, _ := strings.Cut(c, "=")
+		switch clave {
+		case "magnitud":
+			m, err := strconv.Atoi(valor)
+			if err != nil {
+				log.Fatalf("Magnitud inválida: %s", valor)
+			}
+			req.Magnitude = proto.Int32(int32(m))
+		case "ubicacion":
+			latTexto, longTexto, _ := strings.Cut(valor, ",")
+			lat, errLat := strconv.ParseFloat(latTexto, 64)
+			long, errLong := strconv.ParseFloat(longTexto, 64)
+			if errLat != nil || errLong != nil {
+				log.Fatalf("Ubicación inválida: %s", valor)
+			}
+			req.Latitude, req.Longitude = proto.Float64(lat), proto.Float64(long)
+		case "refuerzos":
+			n, err := strconv.Atoi(valor)
+			if err != nil {
+				log.Fatalf("Cantidad de refuerzos inválida: %s", valor)
+			}
+			req.Refuerzos = int32(n)
+		default:
+			log.Fatalf("Cambio desconocido: %s", c)
+		}
+	}
+
+	conn, err := grpc.Dial("10.10.28.57:50051", grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("No se pudo conectar al servicio de asignación: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := pbv2.NewAsignadorClient(conn).ActualizarEmergencia(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error al actualizar emergencia: %v", err)
+	}
+	fmt.Printf("Emergencia %d actualizada: magnitud %d -> %d\n", resp.EmergencyId, resp.MagnitudAnterior, resp.Magnitude)
+	if len(resp.DronesActualizados) > 0 {
+		fmt.Printf("Misiones recalculadas: %s\n", strings.Join(resp.DronesActualizados, ", "))
+	}
+	if resp.RefuerzosEncolados > 0 {
+		fmt.Printf("%d refuerzos en la cola de despacho\n", resp.RefuerzosEncolados)
+	}
 }
 
 // main hace lo siguiente:
 // 1. Carga las emergencias desde un archivo JSON
 // 2. Establece conexión con los servicios gRPC de asignación y monitoreo
-// 3. Envía cada emergencia al servicio de asignación
-// 4. Monitorea las respuestas del servicio de monitoreo
-// 5. Espera confirmación de que cada emergencia ha sido atendida
+// 3. Envía todas las emergencias por un stream al servicio de asignación, mostrando el
+// acuse de cada una (ID, posición en la cola y dron asignado). Si el stream se corta,
+// reenvía todas con las mismas claves de idempotencia, de modo que las ya recibidas no se
+// duplican
+// 4. Monitorea las respuestas del servicio de monitoreo, retomando el stream donde quedó si
+// se corta
+// 5. Espera el evento de extinción o cancelación de cada una de sus emergencias
+//
+// Con "cancelar <emergency_id> [motivo]" solo solicita la cancelación de esa emergencia, y
+// con "actualizar <emergency_id> [magnitud=N] [ubicacion=lat,long] [refuerzos=N]" solo
+// solicita esos cambios.
 
 func main() {
+	if len(os.Args) >= 3 && os.Args[1] == "cancelar" {
+		cancelar(os.Args[2], strings.Join(os.Args[3:], " "))
+		return
+	}
+	if len(os.Args) >= 4 && os.Args[1] == "actualizar" {
+		actualizar(os.Args[2], os.Args[3:])
+		return
+	}
 	if len(os.Args) != 2 {
 		fmt.Println("Uso: ./cliente emergencia.json")
+		fmt.Println("     ./cliente cancelar <emergency_id> [motivo]")
+		fmt.Println("     ./cliente actualizar <emergency_id> [magnitud=N] [ubicacion=lat,long] [refuerzos=N]")
 		return
 	}
 
@@ -74,60 +362,42 @@ func main() {
 		log.Fatalf("No se pudo conectar al servicio de asignación: %v", err)
 	}
 	defer conn1.Close()
-	client := pb.NewAsignadorClient(conn1)
+	client := pbv2.NewAsignadorClient(conn1)
 
 	conn2, err := grpc.Dial("10.10.28.56:50053", grpc.WithInsecure())
 	if err != nil {
 		log.Fatalf("No se pudo conectar al servicio de monitoreo: %v", err)
 	}
 	defer conn2.Close()
-	monitoreo := pb.NewMonitoreoClient(conn2)
+	monitoreo := pbv2.NewMonitoreoClient(conn2)
 
 	ctxMonitoreo, cancelMonitoreo := context.WithCancel(context.Background())
 	defer cancelMonitoreo()
 
-	stream, err := monitoreo.StreamMensajes(ctxMonitoreo, &pb.Vacio{})
+	stream, err := monitoreo.StreamMensajes(ctxMonitoreo, &pbv2.SuscripcionMonitoreo{Modo: pbv2.ModoEntrega_ENTREGA_TIEMPO_REAL})
 	if err != nil {
 		log.Fatalf("Error conectando con monitoreo: %v", err)
 	}
 
-	done := make(chan bool)
+	sg := nuevoSeguimiento(len(emergencias))
 
-	go func() {
-		for {
-			msg, err := stream.Recv()
-			if err != nil {
-				if ctxMonitoreo.Err() != nil {
-					return
-				}
-				log.Printf("Error recibiendo actualización de monitoreo: %v", err)
-				return
-			}
-			fmt.Println(msg.Contenido)
-			if containsExtinguido(msg.Contenido) {
-				done <- true
-			}
-		}
-	}()
+	go seguirMonitoreo(ctxMonitoreo, monitoreo, stream, sg)
 
-	for _, e := range emergencias {
-		fmt.Printf("\nEmergencia actual : %s magnitud %d en x=%d , y=%d\n", e.Name, e.Magnitude, int(e.Latitude), int(e.Longitude))
-
-		_, err := client.EnviarEmergencias(context.Background(), &pb.EmergenciasRequest{
-			Emergencias: []*pb.Emergencia{
-				{
-					Name:      e.Name,
-					Latitude:  float32(e.Latitude),
-					Longitude: float32(e.Longitude),
-					Magnitude: int32(e.Magnitude),
-				},
-			},
-		})
-		if err != nil {
-			log.Fatalf("Error al enviar emergencia: %v", err)
+	claves := nuevasClaves(len(emergencias))
+	for intento := 1; ; intento++ {
+		err := enviarEmergencias(client, emergencias, claves, sg)
+		if err == nil {
+			break
+		}
+		if intento == maxIntentosEnvio {
+			log.Fatalf("Error al enviar emergencias: %v", err)
 		}
+		log.Printf("Envío interrumpido (%v), reintentando con las mismas claves (%d/%d)...", err, intento, maxIntentosEnvio-1)
+		time.Sleep(esperaReintento)
+	}
 
-		<-done
+	for range emergencias {
+		<-sg.listas
 	}
 
 	cancelMonitoreo()
@@ -0,0 +1,83 @@
+// Package despacho reúne la lógica del asignador que no depende de MongoDB ni de RabbitMQ:
+// la cola de emergencias ordenada por prioridad con envejecimiento y la asignación óptima
+// de varias emergencias a varios drones.
+package despacho
+
+import (
+	"container/heap"
+	"time"
+)
+
+// Envejecimiento es cuántos puntos de prioridad gana una emergencia por cada minuto que
+// pasa en la cola, para que las de baja magnitud no esperen indefinidamente.
+const Envejecimiento = 1.0
+
+// Prioridad devuelve la prioridad efectiva en el instante ahora de una emergencia de la
+// magnitud dada que llegó a la cola en llegada.
+func Prioridad(magnitud int32, llegada, ahora time.Time) float64 {
+	return float64(magnitud) + Envejecimiento*ahora.Sub(llegada).Minutes()
+}
+
+// Elemento es lo que la cola necesita saber de una emergencia en espera.
+type Elemento interface {
+	Magnitud() int32
+	Llegada() time.Time
+}
+
+// Cola implementa heap.Interface ordenando por prioridad efectiva descendente; a igual
+// prioridad sale primero la que llegó antes. Se usa con las funciones de container/heap.
+type Cola[T Elemento] []T
+
+func (c Cola[T]) Len() int { return len(c) }
+
+func (c Cola[T]) Less(i, j int) bool {
+	// La diferencia de prioridad entre dos emergencias no depende del instante en que
+	// se compara, porque ambas envejecen al mismo ritmo.
+	dif := float64(c[i].Magnitud()-c[j].Magnitud()) - Envejecimiento*c[i].Llegada().Sub(c[j].Llegada()).Minutes()
+	if dif != 0 {
+		return dif > 0
+	}
+	return c[i].Llegada().Before(c[j].Llegada())
+}
+
+func (c Cola[T]) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+func (c *Cola[T]) Push(x any) { *c = append(*c, x.(T)) }
+
+func (c *Cola[T]) Pop() any {
+	old := *c
+	n := len(old)
+	e := old[n-1]
+	var cero T
+	old[n-1] = cero
+	*c = old[:n-1]
+	return e
+}
+
+// Quitar elimina de la cola los elementos para los que quitar retorna true y restablece el
+// orden del heap.
+func (c *Cola[T]) Quitar(quitar func(T) bool) {
+	restantes := (*c)[:0]
+	for _, e := range *c {
+		if !quitar(e) {
+			restantes = append(restantes, e)
+		}
+	}
+	var cero T
+	for i := len(restantes); i < len(*c); i++ {
+		(*c)[i] = cero
+	}
+	*c = restantes
+	heap.Init(c)
+}
+
+// Ordenada devuelve una copia de la cola en el orden en que se despacharía.
+func (c Cola[T]) Ordenada() []T {
+	copia := make(Cola[T], len(c))
+	copy(copia, c)
+	res := make([]T, 0, len(copia))
+	for copia.Len() > 0 {
+		res = append(res, heap.Pop(&copia).(T))
+	}
+	return res
+}
@@ -0,0 +1,123 @@
+package despacho
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+)
+
+type emergenciaPrueba struct {
+	nombre   string
+	magnitud int32
+	llegada  time.Time
+}
+
+func (e *emergenciaPrueba) Magnitud() int32    { return e.magnitud }
+func (e *emergenciaPrueba) Llegada() time.Time { return e.llegada }
+
+func TestPrioridad(t *testing.T) {
+	llegada := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		espera    time.Duration
+		prioridad float64
+	}{
+		{0, 5},
+		{30 * time.Second, 5.5},
+		{10 * time.Minute, 15},
+	} {
+		if got := Prioridad(5, llegada, llegada.Add(c.espera)); got != c.prioridad {
+			t.Errorf("Prioridad(5) tras %s = %v, se esperaba %v", c.espera, got, c.prioridad)
+		}
+	}
+}
+
+func TestColaOrden(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	casos := []struct {
+		nombre      string
+		emergencias []*emergenciaPrueba
+		orden       []string
+	}{
+		{
+			"por magnitud",
+			[]*emergenciaPrueba{
+				{"baja", 2, t0},
+				{"alta", 9, t0},
+				{"media", 5, t0},
+			},
+			[]string{"alta", "media", "baja"},
+		},
+		{
+			"empate por llegada",
+			[]*emergenciaPrueba{
+				{"segunda", 5, t0.Add(time.Second)},
+				{"primera", 5, t0},
+			},
+			[]string{"primera", "segunda"},
+		},
+		{
+			// La de magnitud 2 lleva 10 minutos esperando: 2+10 supera a 9+0
+			"envejecimiento adelanta a la antigua",
+			[]*emergenciaPrueba{
+				{"nueva", 9, t0.Add(10 * time.Minute)},
+				{"antigua", 2, t0},
+			},
+			[]string{"antigua", "nueva"},
+		},
+		{
+			// Con 5 minutos de espera 2+5 no alcanza a 9
+			"envejecimiento insuficiente",
+			[]*emergenciaPrueba{
+				{"nueva", 9, t0.Add(5 * time.Minute)},
+				{"antigua", 2, t0},
+			},
+			[]string{"nueva", "antigua"},
+		},
+	}
+	for _, c := range casos {
+		t.Run(c.nombre, func(t *testing.T) {
+			var cola Cola[*emergenciaPrueba]
+			for _, e := range c.emergencias {
+				heap.Push(&cola, e)
+			}
+
+			ordenada := cola.Ordenada()
+			if cola.Len() != len(c.emergencias) {
+				t.Fatalf("Ordenada modificó la cola: quedan %d elementos", cola.Len())
+			}
+			for i, e := range ordenada {
+				if e.nombre != c.orden[i] {
+					t.Fatalf("posición %d: %s, se esperaba %s", i, e.nombre, c.orden[i])
+				}
+			}
+			for i := range c.orden {
+				if e := heap.Pop(&cola).(*emergenciaPrueba); e.nombre != c.orden[i] {
+					t.Fatalf("Pop %d: %s, se esperaba %s", i, e.nombre, c.orden[i])
+				}
+			}
+		})
+	}
+}
+
+func TestColaQuitar(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	// Tres refuerzos idénticos de la misma emergencia, como los que encola una actualización
+	refuerzos := []*emergenciaPrueba{{"refuerzo", 3, t0}, {"refuerzo", 3, t0}, {"refuerzo", 3, t0}}
+	otra := &emergenciaPrueba{"otra", 5, t0.Add(time.Second)}
+
+	var cola Cola[*emergenciaPrueba]
+	for _, e := range append([]*emergenciaPrueba{otra}, refuerzos...) {
+		heap.Push(&cola, e)
+	}
+	// Se despacharon dos de los refuerzos: solo esos dos deben salir de la cola
+	despachados := map[*emergenciaPrueba]bool{refuerzos[0]: true, refuerzos[2]: true}
+	cola.Quitar(func(e *emergenciaPrueba) bool { return despachados[e] })
+
+	ordenada := cola.Ordenada()
+	if len(ordenada) != 2 || ordenada[0] != otra || ordenada[1] != refuerzos[1] {
+		t.Fatalf("quedaron %v, se esperaba la otra emergencia y el refuerzo sin despachar", ordenada)
+	}
+	if e := heap.Pop(&cola).(*emergenciaPrueba); e != otra {
+		t.Errorf("Pop tras Quitar: %s, se esperaba otra", e.nombre)
+	}
+}
@@ -0,0 +1,70 @@
+package despacho
+
+import "math"
+
+// AsignacionOptima resuelve el problema de asignación con el método húngaro para una
+// matriz de costos de n filas y m columnas (n <= m). Retorna, para cada fila, la columna
+// que le corresponde en la asignación de costo total mínimo.
+func AsignacionOptima(costo [][]float64) []int {
+	n := len(costo)
+	if n == 0 {
+		return nil
+	}
+	m := len(costo[0])
+
+	// Potenciales u (filas) y v (columnas); fila[j] es la fila asignada a la columna j.
+	// Los índices empiezan en 1 y la columna 0 es auxiliar.
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	fila := make([]int, m+1)
+	previa := make([]int, m+1)
+	for i := 1; i <= n; i++ {
+		fila[0] = i
+		j0 := 0
+		minimo := make([]float64, m+1)
+		usada := make([]bool, m+1)
+		for j := range minimo {
+			minimo[j] = math.Inf(1)
+		}
+		for {
+			usada[j0] = true
+			i0, delta, j1 := fila[j0], math.Inf(1), 0
+			for j := 1; j <= m; j++ {
+				if usada[j] {
+					continue
+				}
+				if c := costo[i0-1][j-1] - u[i0] - v[j]; c < minimo[j] {
+					minimo[j], previa[j] = c, j0
+				}
+				if minimo[j] < delta {
+					delta, j1 = minimo[j], j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if usada[j] {
+					u[fila[j]] += delta
+					v[j] -= delta
+				} else {
+					minimo[j] -= delta
+				}
+			}
+			j0 = j1
+			if fila[j0] == 0 {
+				break
+			}
+		}
+		for j0 != 0 {
+			j1 := previa[j0]
+			fila[j0] = fila[j1]
+			j0 = j1
+		}
+	}
+
+	res := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if fila[j] != 0 {
+			res[fila[j]-1] = j - 1
+		}
+	}
+	return res
+}
@@ -0,0 +1,105 @@
+package despacho
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// costoTotal suma el costo de la asignación indicada, verificando que no repita columnas.
+func costoTotal(t *testing.T, costo [][]float64, asignacion []int) float64 {
+	t.Helper()
+	usadas := make(map[int]bool)
+	total := 0.0
+	for i, j := range asignacion {
+		if usadas[j] {
+			t.Fatalf("la columna %d se asignó dos veces: %v", j, asignacion)
+		}
+		usadas[j] = true
+		total += costo[i][j]
+	}
+	return total
+}
+
+// minimoPorFuerzaBruta prueba todas las asignaciones posibles de filas a columnas.
+func minimoPorFuerzaBruta(costo [][]float64) float64 {
+	mejor := math.Inf(1)
+	usadas := make([]bool, len(costo[0]))
+	var probar func(i int, suma float64)
+	probar = func(i int, suma float64) {
+		if i == len(costo) {
+			mejor = math.Min(mejor, suma)
+			return
+		}
+		for j := range usadas {
+			if !usadas[j] {
+				usadas[j] = true
+				probar(i+1, suma+costo[i][j])
+				usadas[j] = false
+			}
+		}
+	}
+	probar(0, 0)
+	return mejor
+}
+
+func TestAsignacionOptima(t *testing.T) {
+	casos := []struct {
+		nombre string
+		costo  [][]float64
+		espera []int
+	}{
+		{"vacía", nil, nil},
+		{"una fila", [][]float64{{3, 1, 2}}, []int{1}},
+		{"diagonal", [][]float64{{1, 9}, {9, 1}}, []int{0, 1}},
+		{"cruzada", [][]float64{{9, 1}, {1, 9}}, []int{1, 0}},
+		{
+			// La asignación voraz (fila 0 a su mínimo) da 1+100; la óptima, 2+3.
+			"voraz no es óptima",
+			[][]float64{{1, 2}, {3, 100}},
+			[]int{1, 0},
+		},
+		{
+			"más columnas que filas",
+			[][]float64{{4, 1, 6}, {2, 0, 5}},
+			[]int{1, 0},
+		},
+		{
+			"clásico 3x3",
+			[][]float64{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}},
+			[]int{1, 0, 2},
+		},
+	}
+	for _, c := range casos {
+		t.Run(c.nombre, func(t *testing.T) {
+			got := AsignacionOptima(c.costo)
+			if len(got) != len(c.espera) {
+				t.Fatalf("AsignacionOptima() = %v, se esperaba %v", got, c.espera)
+			}
+			for i := range got {
+				if got[i] != c.espera[i] {
+					t.Fatalf("AsignacionOptima() = %v, se esperaba %v", got, c.espera)
+				}
+			}
+		})
+	}
+}
+
+func TestAsignacionOptimaContraFuerzaBruta(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for prueba := 0; prueba < 200; prueba++ {
+		n := 1 + r.Intn(5)
+		m := n + r.Intn(3)
+		costo := make([][]float64, n)
+		for i := range costo {
+			costo[i] = make([]float64, m)
+			for j := range costo[i] {
+				costo[i][j] = float64(r.Intn(50))
+			}
+		}
+		got := costoTotal(t, costo, AsignacionOptima(costo))
+		if espera := minimoPorFuerzaBruta(costo); got != espera {
+			t.Fatalf("costo %v para %v, el mínimo es %v", got, costo, espera)
+		}
+	}
+}
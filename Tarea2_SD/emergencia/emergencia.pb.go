@@ -9,6 +9,7 @@ package emergencia
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,14 +22,206 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Ciclo de vida de una emergencia
+type EstadoEmergencia int32
+
+const (
+	EstadoEmergencia_EMERGENCIA_DESCONOCIDA EstadoEmergencia = 0
+	EstadoEmergencia_EMERGENCIA_PENDIENTE   EstadoEmergencia = 1
+	EstadoEmergencia_EMERGENCIA_EN_CURSO    EstadoEmergencia = 2
+	EstadoEmergencia_EMERGENCIA_EXTINGUIDA  EstadoEmergencia = 3
+	EstadoEmergencia_EMERGENCIA_CANCELADA   EstadoEmergencia = 4
+)
+
+// Enum value maps for EstadoEmergencia.
+var (
+	EstadoEmergencia_name = map[int32]string{
+		0: "EMERGENCIA_DESCONOCIDA",
+		1: "EMERGENCIA_PENDIENTE",
+		2: "EMERGENCIA_EN_CURSO",
+		3: "EMERGENCIA_EXTINGUIDA",
+		4: "EMERGENCIA_CANCELADA",
+	}
+	EstadoEmergencia_value = map[string]int32{
+		"EMERGENCIA_DESCONOCIDA": 0,
+		"EMERGENCIA_PENDIENTE":   1,
+		"EMERGENCIA_EN_CURSO":    2,
+		"EMERGENCIA_EXTINGUIDA":  3,
+		"EMERGENCIA_CANCELADA":   4,
+	}
+)
+
+func (x EstadoEmergencia) Enum() *EstadoEmergencia {
+	p := new(EstadoEmergencia)
+	*p = x
+	return p
+}
+
+func (x EstadoEmergencia) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EstadoEmergencia) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_proto_enumTypes[0].Descriptor()
+}
+
+func (EstadoEmergencia) Type() protoreflect.EnumType {
+	return &file_emergencia_proto_enumTypes[0]
+}
+
+func (x EstadoEmergencia) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EstadoEmergencia.Descriptor instead.
+func (EstadoEmergencia) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{0}
+}
+
+// Estado de un dron en la colección drones
+type EstadoDron int32
+
+const (
+	EstadoDron_DRON_DESCONOCIDO EstadoDron = 0
+	EstadoDron_DRON_DISPONIBLE  EstadoDron = 1
+	// Reservado por el asignador, aún sin despegar
+	EstadoDron_DRON_ASIGNADO  EstadoDron = 2
+	EstadoDron_DRON_EN_MISION EstadoDron = 3
+	EstadoDron_DRON_AVERIADO  EstadoDron = 4
+)
+
+// Enum value maps for EstadoDron.
+var (
+	EstadoDron_name = map[int32]string{
+		0: "DRON_DESCONOCIDO",
+		1: "DRON_DISPONIBLE",
+		2: "DRON_ASIGNADO",
+		3: "DRON_EN_MISION",
+		4: "DRON_AVERIADO",
+	}
+	EstadoDron_value = map[string]int32{
+		"DRON_DESCONOCIDO": 0,
+		"DRON_DISPONIBLE":  1,
+		"DRON_ASIGNADO":    2,
+		"DRON_EN_MISION":   3,
+		"DRON_AVERIADO":    4,
+	}
+)
+
+func (x EstadoDron) Enum() *EstadoDron {
+	p := new(EstadoDron)
+	*p = x
+	return p
+}
+
+func (x EstadoDron) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EstadoDron) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_proto_enumTypes[1].Descriptor()
+}
+
+func (EstadoDron) Type() protoreflect.EnumType {
+	return &file_emergencia_proto_enumTypes[1]
+}
+
+func (x EstadoDron) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EstadoDron.Descriptor instead.
+func (EstadoDron) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{1}
+}
+
+// Tipo de evento publicado en la cola acciones_dron
+type TipoEvento int32
+
+const (
+	TipoEvento_EVENTO_DESCONOCIDO TipoEvento = 0
+	// Un dron recibió la misión
+	TipoEvento_EVENTO_ASIGNADO TipoEvento = 1
+	// El dron vuela hacia la emergencia
+	TipoEvento_EVENTO_EN_CAMINO TipoEvento = 2
+	// El dron llegó y está apagando la emergencia
+	TipoEvento_EVENTO_APAGANDO   TipoEvento = 3
+	TipoEvento_EVENTO_EXTINGUIDA TipoEvento = 4
+	// La emergencia fue cancelada por el asignador
+	TipoEvento_EVENTO_CANCELADA TipoEvento = 5
+	// El dron abandonó una misión cancelada y queda disponible
+	TipoEvento_EVENTO_ABORTADA TipoEvento = 6
+	// La emergencia cambió de magnitud o de ubicación y el dron recalculó su misión
+	TipoEvento_EVENTO_ACTUALIZADA TipoEvento = 7
+	// Un dron de refuerzo terminó su parte y queda disponible
+	TipoEvento_EVENTO_REFUERZO_TERMINADO TipoEvento = 8
+)
+
+// Enum value maps for TipoEvento.
+var (
+	TipoEvento_name = map[int32]string{
+		0: "EVENTO_DESCONOCIDO",
+		1: "EVENTO_ASIGNADO",
+		2: "EVENTO_EN_CAMINO",
+		3: "EVENTO_APAGANDO",
+		4: "EVENTO_EXTINGUIDA",
+		5: "EVENTO_CANCELADA",
+		6: "EVENTO_ABORTADA",
+		7: "EVENTO_ACTUALIZADA",
+		8: "EVENTO_REFUERZO_TERMINADO",
+	}
+	TipoEvento_value = map[string]int32{
+		"EVENTO_DESCONOCIDO":        0,
+		"EVENTO_ASIGNADO":           1,
+		"EVENTO_EN_CAMINO":          2,
+		"EVENTO_APAGANDO":           3,
+		"EVENTO_EXTINGUIDA":         4,
+		"EVENTO_CANCELADA":          5,
+		"EVENTO_ABORTADA":           6,
+		"EVENTO_ACTUALIZADA":        7,
+		"EVENTO_REFUERZO_TERMINADO": 8,
+	}
+)
+
+func (x TipoEvento) Enum() *TipoEvento {
+	p := new(TipoEvento)
+	*p = x
+	return p
+}
+
+func (x TipoEvento) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TipoEvento) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_proto_enumTypes[2].Descriptor()
+}
+
+func (TipoEvento) Type() protoreflect.EnumType {
+	return &file_emergencia_proto_enumTypes[2]
+}
+
+func (x TipoEvento) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TipoEvento.Descriptor instead.
+func (TipoEvento) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{2}
+}
+
 type Emergencia struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Latitude      float32                `protobuf:"fixed32,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float32                `protobuf:"fixed32,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Magnitude     int32                  `protobuf:"varint,4,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude  float32                `protobuf:"fixed32,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32                `protobuf:"fixed32,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Magnitude int32                  `protobuf:"varint,4,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	// Clave opcional elegida por el cliente. Si llega otra emergencia con la misma clave
+	// dentro de la ventana de idempotencia, el asignador devuelve la emergencia original en
+	// vez de crear una nueva.
+	ClaveIdempotencia string `protobuf:"bytes,5,opt,name=clave_idempotencia,json=claveIdempotencia,proto3" json:"clave_idempotencia,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Emergencia) Reset() {
@@ -89,6 +282,13 @@ func (x *Emergencia) GetMagnitude() int32 {
 	return 0
 }
 
+func (x *Emergencia) GetClaveIdempotencia() string {
+	if x != nil {
+		return x.ClaveIdempotencia
+	}
+	return ""
+}
+
 type EmergenciasRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Emergencias   []*Emergencia          `protobuf:"bytes,1,rep,name=emergencias,proto3" json:"emergencias,omitempty"`
@@ -134,13 +334,23 @@ func (x *EmergenciasRequest) GetEmergencias() []*Emergencia {
 }
 
 type EmergenciaAsignada struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmergencyId   int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Latitude      float32                `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float32                `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Magnitude     int32                  `protobuf:"varint,5,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
-	DronId        string                 `protobuf:"bytes,6,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude    float32                `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32                `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Magnitude   int32                  `protobuf:"varint,5,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	DronId      string                 `protobuf:"bytes,6,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	Estado      EstadoEmergencia       `protobuf:"varint,7,opt,name=estado,proto3,enum=emergencia.EstadoEmergencia" json:"estado,omitempty"`
+	ReportedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	AssignedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	// El asignador los deja vacíos; el dron los completa en su copia de la misión al llegar a
+	// la emergencia y al apagarla, y los publica en los eventos de monitoreo
+	ArrivedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=arrived_at,json=arrivedAt,proto3" json:"arrived_at,omitempty"`
+	ExtinguishedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=extinguished_at,json=extinguishedAt,proto3" json:"extinguished_at,omitempty"`
+	// El dron apoya a otro que atiende la misma emergencia: apaga su parte de la magnitud
+	// pero no da la emergencia por extinguida
+	Refuerzo      bool `protobuf:"varint,12,opt,name=refuerzo,proto3" json:"refuerzo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -217,10 +427,54 @@ func (x *EmergenciaAsignada) GetDronId() string {
 	return ""
 }
 
+func (x *EmergenciaAsignada) GetEstado() EstadoEmergencia {
+	if x != nil {
+		return x.Estado
+	}
+	return EstadoEmergencia_EMERGENCIA_DESCONOCIDA
+}
+
+func (x *EmergenciaAsignada) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaAsignada) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaAsignada) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaAsignada) GetExtinguishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtinguishedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaAsignada) GetRefuerzo() bool {
+	if x != nil {
+		return x.Refuerzo
+	}
+	return false
+}
+
 // Respuesta simple
 type Respuesta struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mensaje       string                 `protobuf:"bytes,1,opt,name=mensaje,proto3" json:"mensaje,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Mensaje string                 `protobuf:"bytes,1,opt,name=mensaje,proto3" json:"mensaje,omitempty"`
+	// Emergencias que quedaron en la cola de despacho, con su posición
+	Encoladas     []*EmergenciaEnCola `protobuf:"bytes,2,rep,name=encoladas,proto3" json:"encoladas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -262,16 +516,185 @@ func (x *Respuesta) GetMensaje() string {
 	return ""
 }
 
+func (x *Respuesta) GetEncoladas() []*EmergenciaEnCola {
+	if x != nil {
+		return x.Encoladas
+	}
+	return nil
+}
+
+// Evento de una misión, publicado como JSON en acciones_dron
+type EventoMonitoreo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Tipo        TipoEvento             `protobuf:"varint,1,opt,name=tipo,proto3,enum=emergencia.TipoEvento" json:"tipo,omitempty"`
+	EmergencyId int32                  `protobuf:"varint,2,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	DronId      string                 `protobuf:"bytes,3,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	// Nombre de la emergencia
+	Emergencia string `protobuf:"bytes,4,opt,name=emergencia,proto3" json:"emergencia,omitempty"`
+	// Posición del dron al publicar el evento
+	Latitude  float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Avance entre 0 y 1 de la etapa actual (en camino o apagando)
+	Progreso  float64                `protobuf:"fixed64,7,opt,name=progreso,proto3" json:"progreso,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hitos de la misión alcanzados hasta este evento; los que aún no ocurren van vacíos
+	AssignedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	ArrivedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=arrived_at,json=arrivedAt,proto3" json:"arrived_at,omitempty"`
+	ExtinguishedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=extinguished_at,json=extinguishedAt,proto3" json:"extinguished_at,omitempty"`
+	// Ubicación de la emergencia, que no cambia mientras el dron se desplaza
+	EmergencyLatitude  float64 `protobuf:"fixed64,12,opt,name=emergency_latitude,json=emergencyLatitude,proto3" json:"emergency_latitude,omitempty"`
+	EmergencyLongitude float64 `protobuf:"fixed64,13,opt,name=emergency_longitude,json=emergencyLongitude,proto3" json:"emergency_longitude,omitempty"`
+	// Hora en que se reportó la emergencia
+	ReportedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventoMonitoreo) Reset() {
+	*x = EventoMonitoreo{}
+	mi := &file_emergencia_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventoMonitoreo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventoMonitoreo) ProtoMessage() {}
+
+func (x *EventoMonitoreo) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventoMonitoreo.ProtoReflect.Descriptor instead.
+func (*EventoMonitoreo) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventoMonitoreo) GetTipo() TipoEvento {
+	if x != nil {
+		return x.Tipo
+	}
+	return TipoEvento_EVENTO_DESCONOCIDO
+}
+
+func (x *EventoMonitoreo) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *EventoMonitoreo) GetEmergencia() string {
+	if x != nil {
+		return x.Emergencia
+	}
+	return ""
+}
+
+func (x *EventoMonitoreo) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetProgreso() float64 {
+	if x != nil {
+		return x.Progreso
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetExtinguishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtinguishedAt
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetEmergencyLatitude() float64 {
+	if x != nil {
+		return x.EmergencyLatitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetEmergencyLongitude() float64 {
+	if x != nil {
+		return x.EmergencyLongitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
 type MensajeMonitoreo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Contenido     string                 `protobuf:"bytes,1,opt,name=contenido,proto3" json:"contenido,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Texto legible del evento, para mostrar
+	Contenido string                 `protobuf:"bytes,1,opt,name=contenido,proto3" json:"contenido,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Vacío si el mensaje llegó como texto libre de un servicio sin actualizar
+	Evento *EventoMonitoreo `protobuf:"bytes,3,opt,name=evento,proto3" json:"evento,omitempty"`
+	// Presente solo en los avisos de salto: el suscriptor se atrasó más de lo que retiene el
+	// servidor y los mensajes intermedios se descartaron sin entregárselos
+	Salto         *SaltoMonitoreo `protobuf:"bytes,4,opt,name=salto,proto3" json:"salto,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MensajeMonitoreo) Reset() {
 	*x = MensajeMonitoreo{}
-	mi := &file_emergencia_proto_msgTypes[4]
+	mi := &file_emergencia_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -283,7 +706,7 @@ func (x *MensajeMonitoreo) String() string {
 func (*MensajeMonitoreo) ProtoMessage() {}
 
 func (x *MensajeMonitoreo) ProtoReflect() protoreflect.Message {
-	mi := &file_emergencia_proto_msgTypes[4]
+	mi := &file_emergencia_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,7 +719,7 @@ func (x *MensajeMonitoreo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MensajeMonitoreo.ProtoReflect.Descriptor instead.
 func (*MensajeMonitoreo) Descriptor() ([]byte, []int) {
-	return file_emergencia_proto_rawDescGZIP(), []int{4}
+	return file_emergencia_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MensajeMonitoreo) GetContenido() string {
@@ -306,27 +729,52 @@ func (x *MensajeMonitoreo) GetContenido() string {
 	return ""
 }
 
-type Vacio struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *MensajeMonitoreo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *MensajeMonitoreo) GetEvento() *EventoMonitoreo {
+	if x != nil {
+		return x.Evento
+	}
+	return nil
+}
+
+func (x *MensajeMonitoreo) GetSalto() *SaltoMonitoreo {
+	if x != nil {
+		return x.Salto
+	}
+	return nil
+}
+
+// Mensajes que un suscriptor de StreamMensajes no alcanzó a recibir
+type SaltoMonitoreo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Perdidos uint64                 `protobuf:"varint,1,opt,name=perdidos,proto3" json:"perdidos,omitempty"`
+	// Secuencia del primer mensaje perdido
+	Desde         uint64 `protobuf:"varint,2,opt,name=desde,proto3" json:"desde,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Vacio) Reset() {
-	*x = Vacio{}
-	mi := &file_emergencia_proto_msgTypes[5]
+func (x *SaltoMonitoreo) Reset() {
+	*x = SaltoMonitoreo{}
+	mi := &file_emergencia_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Vacio) String() string {
+func (x *SaltoMonitoreo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vacio) ProtoMessage() {}
+func (*SaltoMonitoreo) ProtoMessage() {}
 
-func (x *Vacio) ProtoReflect() protoreflect.Message {
-	mi := &file_emergencia_proto_msgTypes[5]
+func (x *SaltoMonitoreo) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,43 +785,1455 @@ func (x *Vacio) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vacio.ProtoReflect.Descriptor instead.
-func (*Vacio) Descriptor() ([]byte, []int) {
-	return file_emergencia_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SaltoMonitoreo.ProtoReflect.Descriptor instead.
+func (*SaltoMonitoreo) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{6}
 }
 
-var File_emergencia_proto protoreflect.FileDescriptor
+func (x *SaltoMonitoreo) GetPerdidos() uint64 {
+	if x != nil {
+		return x.Perdidos
+	}
+	return 0
+}
 
-const file_emergencia_proto_rawDesc = "" +
-	"\n" +
-	"\x10emergencia.proto\x12\n" +
-	"emergencia\"x\n" +
-	"\n" +
-	"Emergencia\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
-	"\blatitude\x18\x02 \x01(\x02R\blatitude\x12\x1c\n" +
+func (x *SaltoMonitoreo) GetDesde() uint64 {
+	if x != nil {
+		return x.Desde
+	}
+	return 0
+}
+
+type Vacio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vacio) Reset() {
+	*x = Vacio{}
+	mi := &file_emergencia_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vacio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vacio) ProtoMessage() {}
+
+func (x *Vacio) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vacio.ProtoReflect.Descriptor instead.
+func (*Vacio) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{7}
+}
+
+// Dron registrado en la colección drones
+type InfoDron struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Estado    EstadoDron             `protobuf:"varint,4,opt,name=estado,proto3,enum=emergencia.EstadoDron" json:"estado,omitempty"`
+	// Dirección host:puerto del servicio de drones que lo atiende
+	Address       string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Mantenimiento bool   `protobuf:"varint,6,opt,name=mantenimiento,proto3" json:"mantenimiento,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InfoDron) Reset() {
+	*x = InfoDron{}
+	mi := &file_emergencia_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfoDron) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfoDron) ProtoMessage() {}
+
+func (x *InfoDron) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfoDron.ProtoReflect.Descriptor instead.
+func (*InfoDron) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InfoDron) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InfoDron) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *InfoDron) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *InfoDron) GetEstado() EstadoDron {
+	if x != nil {
+		return x.Estado
+	}
+	return EstadoDron_DRON_DESCONOCIDO
+}
+
+func (x *InfoDron) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *InfoDron) GetMantenimiento() bool {
+	if x != nil {
+		return x.Mantenimiento
+	}
+	return false
+}
+
+type RegistrarDronRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrarDronRequest) Reset() {
+	*x = RegistrarDronRequest{}
+	mi := &file_emergencia_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrarDronRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrarDronRequest) ProtoMessage() {}
+
+func (x *RegistrarDronRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrarDronRequest.ProtoReflect.Descriptor instead.
+func (*RegistrarDronRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RegistrarDronRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegistrarDronRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *RegistrarDronRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *RegistrarDronRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DronRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DronRequest) Reset() {
+	*x = DronRequest{}
+	mi := &file_emergencia_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DronRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DronRequest) ProtoMessage() {}
+
+func (x *DronRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DronRequest.ProtoReflect.Descriptor instead.
+func (*DronRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DronRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type MantenimientoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mantenimiento bool                   `protobuf:"varint,2,opt,name=mantenimiento,proto3" json:"mantenimiento,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MantenimientoRequest) Reset() {
+	*x = MantenimientoRequest{}
+	mi := &file_emergencia_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MantenimientoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MantenimientoRequest) ProtoMessage() {}
+
+func (x *MantenimientoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MantenimientoRequest.ProtoReflect.Descriptor instead.
+func (*MantenimientoRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MantenimientoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MantenimientoRequest) GetMantenimiento() bool {
+	if x != nil {
+		return x.Mantenimiento
+	}
+	return false
+}
+
+type ListaDrones struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drones        []*InfoDron            `protobuf:"bytes,1,rep,name=drones,proto3" json:"drones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListaDrones) Reset() {
+	*x = ListaDrones{}
+	mi := &file_emergencia_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListaDrones) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListaDrones) ProtoMessage() {}
+
+func (x *ListaDrones) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListaDrones.ProtoReflect.Descriptor instead.
+func (*ListaDrones) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListaDrones) GetDrones() []*InfoDron {
+	if x != nil {
+		return x.Drones
+	}
+	return nil
+}
+
+// Emergencia que espera en la cola de despacho del asignador
+type EmergenciaEnCola struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId    int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Magnitude      int32                  `protobuf:"varint,3,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	Posicion       int32                  `protobuf:"varint,4,opt,name=posicion,proto3" json:"posicion,omitempty"`
+	EsperaSegundos float64                `protobuf:"fixed64,5,opt,name=espera_segundos,json=esperaSegundos,proto3" json:"espera_segundos,omitempty"`
+	Prioridad      float64                `protobuf:"fixed64,6,opt,name=prioridad,proto3" json:"prioridad,omitempty"`
+	Motivo         string                 `protobuf:"bytes,7,opt,name=motivo,proto3" json:"motivo,omitempty"`
+	// Dron asignado, si la emergencia ya salió de la cola
+	DronId string `protobuf:"bytes,8,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	// La emergencia repetía una clave_idempotencia ya recibida y no se volvió a encolar
+	Duplicada     bool `protobuf:"varint,9,opt,name=duplicada,proto3" json:"duplicada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmergenciaEnCola) Reset() {
+	*x = EmergenciaEnCola{}
+	mi := &file_emergencia_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergenciaEnCola) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergenciaEnCola) ProtoMessage() {}
+
+func (x *EmergenciaEnCola) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergenciaEnCola.ProtoReflect.Descriptor instead.
+func (*EmergenciaEnCola) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EmergenciaEnCola) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmergenciaEnCola) GetMagnitude() int32 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetPosicion() int32 {
+	if x != nil {
+		return x.Posicion
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetEsperaSegundos() float64 {
+	if x != nil {
+		return x.EsperaSegundos
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetPrioridad() float64 {
+	if x != nil {
+		return x.Prioridad
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetMotivo() string {
+	if x != nil {
+		return x.Motivo
+	}
+	return ""
+}
+
+func (x *EmergenciaEnCola) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *EmergenciaEnCola) GetDuplicada() bool {
+	if x != nil {
+		return x.Duplicada
+	}
+	return false
+}
+
+type EstadoCola struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emergencias   []*EmergenciaEnCola    `protobuf:"bytes,1,rep,name=emergencias,proto3" json:"emergencias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstadoCola) Reset() {
+	*x = EstadoCola{}
+	mi := &file_emergencia_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstadoCola) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstadoCola) ProtoMessage() {}
+
+func (x *EstadoCola) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstadoCola.ProtoReflect.Descriptor instead.
+func (*EstadoCola) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EstadoCola) GetEmergencias() []*EmergenciaEnCola {
+	if x != nil {
+		return x.Emergencias
+	}
+	return nil
+}
+
+// Acuse de recibo de una emergencia enviada por EnviarEmergenciasStream. Cada emergencia
+// recibe un acuse al entrar a la cola y otro cuando se le asigna un dron.
+type AcuseEmergencia struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Posición de la emergencia en el stream del cliente, empezando en 0
+	Indice      int32 `protobuf:"varint,1,opt,name=indice,proto3" json:"indice,omitempty"`
+	EmergencyId int32 `protobuf:"varint,2,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	// Posición en la cola de despacho; 0 cuando ya fue asignada
+	Posicion      int32  `protobuf:"varint,3,opt,name=posicion,proto3" json:"posicion,omitempty"`
+	DronId        string `protobuf:"bytes,4,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	Mensaje       string `protobuf:"bytes,5,opt,name=mensaje,proto3" json:"mensaje,omitempty"`
+	Duplicada     bool   `protobuf:"varint,6,opt,name=duplicada,proto3" json:"duplicada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcuseEmergencia) Reset() {
+	*x = AcuseEmergencia{}
+	mi := &file_emergencia_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcuseEmergencia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcuseEmergencia) ProtoMessage() {}
+
+func (x *AcuseEmergencia) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcuseEmergencia.ProtoReflect.Descriptor instead.
+func (*AcuseEmergencia) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcuseEmergencia) GetIndice() int32 {
+	if x != nil {
+		return x.Indice
+	}
+	return 0
+}
+
+func (x *AcuseEmergencia) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *AcuseEmergencia) GetPosicion() int32 {
+	if x != nil {
+		return x.Posicion
+	}
+	return 0
+}
+
+func (x *AcuseEmergencia) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *AcuseEmergencia) GetMensaje() string {
+	if x != nil {
+		return x.Mensaje
+	}
+	return ""
+}
+
+func (x *AcuseEmergencia) GetDuplicada() bool {
+	if x != nil {
+		return x.Duplicada
+	}
+	return false
+}
+
+type CancelarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId   int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Motivo        string                 `protobuf:"bytes,2,opt,name=motivo,proto3" json:"motivo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelarRequest) Reset() {
+	*x = CancelarRequest{}
+	mi := &file_emergencia_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelarRequest) ProtoMessage() {}
+
+func (x *CancelarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelarRequest.ProtoReflect.Descriptor instead.
+func (*CancelarRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelarRequest) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *CancelarRequest) GetMotivo() string {
+	if x != nil {
+		return x.Motivo
+	}
+	return ""
+}
+
+type AbortarRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId   int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	DronId        string                 `protobuf:"bytes,2,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortarRequest) Reset() {
+	*x = AbortarRequest{}
+	mi := &file_emergencia_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortarRequest) ProtoMessage() {}
+
+func (x *AbortarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortarRequest.ProtoReflect.Descriptor instead.
+func (*AbortarRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AbortarRequest) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *AbortarRequest) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+// Nuevos datos de la emergencia que atiende un dron
+type ActualizarMisionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	DronId      string                 `protobuf:"bytes,2,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	// Magnitud que le corresponde apagar a este dron
+	Magnitude     int32   `protobuf:"varint,3,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	Latitude      float32 `protobuf:"fixed32,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float32 `protobuf:"fixed32,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActualizarMisionRequest) Reset() {
+	*x = ActualizarMisionRequest{}
+	mi := &file_emergencia_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActualizarMisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActualizarMisionRequest) ProtoMessage() {}
+
+func (x *ActualizarMisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActualizarMisionRequest.ProtoReflect.Descriptor instead.
+func (*ActualizarMisionRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActualizarMisionRequest) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *ActualizarMisionRequest) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *ActualizarMisionRequest) GetMagnitude() int32 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *ActualizarMisionRequest) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ActualizarMisionRequest) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// Intento de entregar una emergencia a un dron
+type IntentoAsignacion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DronId        string                 `protobuf:"bytes,1,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	Numero        int32                  `protobuf:"varint,2,opt,name=numero,proto3" json:"numero,omitempty"`
+	Inicio        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=inicio,proto3" json:"inicio,omitempty"`
+	Fin           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fin,proto3" json:"fin,omitempty"`
+	Resultado     string                 `protobuf:"bytes,5,opt,name=resultado,proto3" json:"resultado,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntentoAsignacion) Reset() {
+	*x = IntentoAsignacion{}
+	mi := &file_emergencia_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntentoAsignacion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntentoAsignacion) ProtoMessage() {}
+
+func (x *IntentoAsignacion) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntentoAsignacion.ProtoReflect.Descriptor instead.
+func (*IntentoAsignacion) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IntentoAsignacion) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *IntentoAsignacion) GetNumero() int32 {
+	if x != nil {
+		return x.Numero
+	}
+	return 0
+}
+
+func (x *IntentoAsignacion) GetInicio() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Inicio
+	}
+	return nil
+}
+
+func (x *IntentoAsignacion) GetFin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fin
+	}
+	return nil
+}
+
+func (x *IntentoAsignacion) GetResultado() string {
+	if x != nil {
+		return x.Resultado
+	}
+	return ""
+}
+
+func (x *IntentoAsignacion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Emergencia tal como está registrada en la colección emergencias
+type EmergenciaRegistrada struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude    float32                `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32                `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Magnitude   int32                  `protobuf:"varint,5,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	// Texto del estado tal como está en MongoDB; usar estado
+	//
+	// Deprecated: Marked as deprecated in emergencia.proto.
+	Status            string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	DronId            string                 `protobuf:"bytes,7,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	ReportedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	MotivoCancelacion string                 `protobuf:"bytes,9,opt,name=motivo_cancelacion,json=motivoCancelacion,proto3" json:"motivo_cancelacion,omitempty"`
+	Intentos          []*IntentoAsignacion   `protobuf:"bytes,10,rep,name=intentos,proto3" json:"intentos,omitempty"`
+	Estado            EstadoEmergencia       `protobuf:"varint,11,opt,name=estado,proto3,enum=emergencia.EstadoEmergencia" json:"estado,omitempty"`
+	AssignedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	ArrivedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=arrived_at,json=arrivedAt,proto3" json:"arrived_at,omitempty"`
+	ExtinguishedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=extinguished_at,json=extinguishedAt,proto3" json:"extinguished_at,omitempty"`
+	CancelledAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	ClaveIdempotencia string                 `protobuf:"bytes,16,opt,name=clave_idempotencia,json=claveIdempotencia,proto3" json:"clave_idempotencia,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EmergenciaRegistrada) Reset() {
+	*x = EmergenciaRegistrada{}
+	mi := &file_emergencia_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergenciaRegistrada) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergenciaRegistrada) ProtoMessage() {}
+
+func (x *EmergenciaRegistrada) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergenciaRegistrada.ProtoReflect.Descriptor instead.
+func (*EmergenciaRegistrada) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EmergenciaRegistrada) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *EmergenciaRegistrada) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *EmergenciaRegistrada) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *EmergenciaRegistrada) GetMagnitude() int32 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in emergencia.proto.
+func (x *EmergenciaRegistrada) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetMotivoCancelacion() string {
+	if x != nil {
+		return x.MotivoCancelacion
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetIntentos() []*IntentoAsignacion {
+	if x != nil {
+		return x.Intentos
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetEstado() EstadoEmergencia {
+	if x != nil {
+		return x.Estado
+	}
+	return EstadoEmergencia_EMERGENCIA_DESCONOCIDA
+}
+
+func (x *EmergenciaRegistrada) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetExtinguishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtinguishedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetClaveIdempotencia() string {
+	if x != nil {
+		return x.ClaveIdempotencia
+	}
+	return ""
+}
+
+type GetEmergenciaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId   int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmergenciaRequest) Reset() {
+	*x = GetEmergenciaRequest{}
+	mi := &file_emergencia_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmergenciaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmergenciaRequest) ProtoMessage() {}
+
+func (x *GetEmergenciaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmergenciaRequest.ProtoReflect.Descriptor instead.
+func (*GetEmergenciaRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetEmergenciaRequest) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+// Filtros de ListEmergencias; los campos vacíos o en cero no filtran
+type ListEmergenciasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Texto del estado tal como está en MongoDB; usar estado
+	//
+	// Deprecated: Marked as deprecated in emergencia.proto.
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MagnitudMin   int32                  `protobuf:"varint,2,opt,name=magnitud_min,json=magnitudMin,proto3" json:"magnitud_min,omitempty"`
+	MagnitudMax   int32                  `protobuf:"varint,3,opt,name=magnitud_max,json=magnitudMax,proto3" json:"magnitud_max,omitempty"`
+	DronId        string                 `protobuf:"bytes,4,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	Desde         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=desde,proto3" json:"desde,omitempty"`
+	Hasta         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=hasta,proto3" json:"hasta,omitempty"`
+	TamanoPagina  int32                  `protobuf:"varint,7,opt,name=tamano_pagina,json=tamanoPagina,proto3" json:"tamano_pagina,omitempty"`
+	TokenPagina   string                 `protobuf:"bytes,8,opt,name=token_pagina,json=tokenPagina,proto3" json:"token_pagina,omitempty"`
+	Estado        EstadoEmergencia       `protobuf:"varint,9,opt,name=estado,proto3,enum=emergencia.EstadoEmergencia" json:"estado,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmergenciasRequest) Reset() {
+	*x = ListEmergenciasRequest{}
+	mi := &file_emergencia_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergenciasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergenciasRequest) ProtoMessage() {}
+
+func (x *ListEmergenciasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergenciasRequest.ProtoReflect.Descriptor instead.
+func (*ListEmergenciasRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{22}
+}
+
+// Deprecated: Marked as deprecated in emergencia.proto.
+func (x *ListEmergenciasRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListEmergenciasRequest) GetMagnitudMin() int32 {
+	if x != nil {
+		return x.MagnitudMin
+	}
+	return 0
+}
+
+func (x *ListEmergenciasRequest) GetMagnitudMax() int32 {
+	if x != nil {
+		return x.MagnitudMax
+	}
+	return 0
+}
+
+func (x *ListEmergenciasRequest) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *ListEmergenciasRequest) GetDesde() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Desde
+	}
+	return nil
+}
+
+func (x *ListEmergenciasRequest) GetHasta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Hasta
+	}
+	return nil
+}
+
+func (x *ListEmergenciasRequest) GetTamanoPagina() int32 {
+	if x != nil {
+		return x.TamanoPagina
+	}
+	return 0
+}
+
+func (x *ListEmergenciasRequest) GetTokenPagina() string {
+	if x != nil {
+		return x.TokenPagina
+	}
+	return ""
+}
+
+func (x *ListEmergenciasRequest) GetEstado() EstadoEmergencia {
+	if x != nil {
+		return x.Estado
+	}
+	return EstadoEmergencia_EMERGENCIA_DESCONOCIDA
+}
+
+type ListEmergenciasResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Emergencias    []*EmergenciaRegistrada `protobuf:"bytes,1,rep,name=emergencias,proto3" json:"emergencias,omitempty"`
+	SiguienteToken string                  `protobuf:"bytes,2,opt,name=siguiente_token,json=siguienteToken,proto3" json:"siguiente_token,omitempty"`
+	Total          int64                   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEmergenciasResponse) Reset() {
+	*x = ListEmergenciasResponse{}
+	mi := &file_emergencia_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergenciasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergenciasResponse) ProtoMessage() {}
+
+func (x *ListEmergenciasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergenciasResponse.ProtoReflect.Descriptor instead.
+func (*ListEmergenciasResponse) Descriptor() ([]byte, []int) {
+	return file_emergencia_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListEmergenciasResponse) GetEmergencias() []*EmergenciaRegistrada {
+	if x != nil {
+		return x.Emergencias
+	}
+	return nil
+}
+
+func (x *ListEmergenciasResponse) GetSiguienteToken() string {
+	if x != nil {
+		return x.SiguienteToken
+	}
+	return ""
+}
+
+func (x *ListEmergenciasResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_emergencia_proto protoreflect.FileDescriptor
+
+const file_emergencia_proto_rawDesc = "" +
+	"\n" +
+	"\x10emergencia.proto\x12\n" +
+	"emergencia\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x01\n" +
+	"\n" +
+	"Emergencia\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x02R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x03 \x01(\x02R\tlongitude\x12\x1c\n" +
-	"\tmagnitude\x18\x04 \x01(\x05R\tmagnitude\"N\n" +
+	"\tmagnitude\x18\x04 \x01(\x05R\tmagnitude\x12-\n" +
+	"\x12clave_idempotencia\x18\x05 \x01(\tR\x11claveIdempotencia\"N\n" +
 	"\x12EmergenciasRequest\x128\n" +
-	"\vemergencias\x18\x01 \x03(\v2\x16.emergencia.EmergenciaR\vemergencias\"\xbc\x01\n" +
+	"\vemergencias\x18\x01 \x03(\v2\x16.emergencia.EmergenciaR\vemergencias\"\x88\x04\n" +
 	"\x12EmergenciaAsignada\x12!\n" +
 	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\blatitude\x18\x03 \x01(\x02R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x04 \x01(\x02R\tlongitude\x12\x1c\n" +
 	"\tmagnitude\x18\x05 \x01(\x05R\tmagnitude\x12\x17\n" +
-	"\adron_id\x18\x06 \x01(\tR\x06dronId\"%\n" +
+	"\adron_id\x18\x06 \x01(\tR\x06dronId\x124\n" +
+	"\x06estado\x18\a \x01(\x0e2\x1c.emergencia.EstadoEmergenciaR\x06estado\x12;\n" +
+	"\vreported_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reportedAt\x12;\n" +
+	"\vassigned_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x129\n" +
+	"\n" +
+	"arrived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tarrivedAt\x12C\n" +
+	"\x0fextinguished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eextinguishedAt\x12\x1a\n" +
+	"\brefuerzo\x18\f \x01(\bR\brefuerzo\"a\n" +
 	"\tRespuesta\x12\x18\n" +
-	"\amensaje\x18\x01 \x01(\tR\amensaje\"0\n" +
+	"\amensaje\x18\x01 \x01(\tR\amensaje\x12:\n" +
+	"\tencoladas\x18\x02 \x03(\v2\x1c.emergencia.EmergenciaEnColaR\tencoladas\"\x83\x05\n" +
+	"\x0fEventoMonitoreo\x12*\n" +
+	"\x04tipo\x18\x01 \x01(\x0e2\x16.emergencia.TipoEventoR\x04tipo\x12!\n" +
+	"\femergency_id\x18\x02 \x01(\x05R\vemergencyId\x12\x17\n" +
+	"\adron_id\x18\x03 \x01(\tR\x06dronId\x12\x1e\n" +
+	"\n" +
+	"emergencia\x18\x04 \x01(\tR\n" +
+	"emergencia\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\bprogreso\x18\a \x01(\x01R\bprogreso\x128\n" +
+	"\ttimestamp\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12;\n" +
+	"\vassigned_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x129\n" +
+	"\n" +
+	"arrived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tarrivedAt\x12C\n" +
+	"\x0fextinguished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eextinguishedAt\x12-\n" +
+	"\x12emergency_latitude\x18\f \x01(\x01R\x11emergencyLatitude\x12/\n" +
+	"\x13emergency_longitude\x18\r \x01(\x01R\x12emergencyLongitude\x12;\n" +
+	"\vreported_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reportedAt\"\xd1\x01\n" +
 	"\x10MensajeMonitoreo\x12\x1c\n" +
-	"\tcontenido\x18\x01 \x01(\tR\tcontenido\"\a\n" +
-	"\x05Vacio2W\n" +
+	"\tcontenido\x18\x01 \x01(\tR\tcontenido\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x123\n" +
+	"\x06evento\x18\x03 \x01(\v2\x1b.emergencia.EventoMonitoreoR\x06evento\x120\n" +
+	"\x05salto\x18\x04 \x01(\v2\x1a.emergencia.SaltoMonitoreoR\x05salto\"B\n" +
+	"\x0eSaltoMonitoreo\x12\x1a\n" +
+	"\bperdidos\x18\x01 \x01(\x04R\bperdidos\x12\x14\n" +
+	"\x05desde\x18\x02 \x01(\x04R\x05desde\"\a\n" +
+	"\x05Vacio\"\xc4\x01\n" +
+	"\bInfoDron\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12.\n" +
+	"\x06estado\x18\x04 \x01(\x0e2\x16.emergencia.EstadoDronR\x06estado\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12$\n" +
+	"\rmantenimiento\x18\x06 \x01(\bR\rmantenimiento\"z\n" +
+	"\x14RegistrarDronRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\"\x1d\n" +
+	"\vDronRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"L\n" +
+	"\x14MantenimientoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\rmantenimiento\x18\x02 \x01(\bR\rmantenimiento\";\n" +
+	"\vListaDrones\x12,\n" +
+	"\x06drones\x18\x01 \x03(\v2\x14.emergencia.InfoDronR\x06drones\"\x99\x02\n" +
+	"\x10EmergenciaEnCola\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tmagnitude\x18\x03 \x01(\x05R\tmagnitude\x12\x1a\n" +
+	"\bposicion\x18\x04 \x01(\x05R\bposicion\x12'\n" +
+	"\x0fespera_segundos\x18\x05 \x01(\x01R\x0eesperaSegundos\x12\x1c\n" +
+	"\tprioridad\x18\x06 \x01(\x01R\tprioridad\x12\x16\n" +
+	"\x06motivo\x18\a \x01(\tR\x06motivo\x12\x17\n" +
+	"\adron_id\x18\b \x01(\tR\x06dronId\x12\x1c\n" +
+	"\tduplicada\x18\t \x01(\bR\tduplicada\"L\n" +
+	"\n" +
+	"EstadoCola\x12>\n" +
+	"\vemergencias\x18\x01 \x03(\v2\x1c.emergencia.EmergenciaEnColaR\vemergencias\"\xb9\x01\n" +
+	"\x0fAcuseEmergencia\x12\x16\n" +
+	"\x06indice\x18\x01 \x01(\x05R\x06indice\x12!\n" +
+	"\femergency_id\x18\x02 \x01(\x05R\vemergencyId\x12\x1a\n" +
+	"\bposicion\x18\x03 \x01(\x05R\bposicion\x12\x17\n" +
+	"\adron_id\x18\x04 \x01(\tR\x06dronId\x12\x18\n" +
+	"\amensaje\x18\x05 \x01(\tR\amensaje\x12\x1c\n" +
+	"\tduplicada\x18\x06 \x01(\bR\tduplicada\"L\n" +
+	"\x0fCancelarRequest\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x16\n" +
+	"\x06motivo\x18\x02 \x01(\tR\x06motivo\"L\n" +
+	"\x0eAbortarRequest\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x17\n" +
+	"\adron_id\x18\x02 \x01(\tR\x06dronId\"\xad\x01\n" +
+	"\x17ActualizarMisionRequest\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x17\n" +
+	"\adron_id\x18\x02 \x01(\tR\x06dronId\x12\x1c\n" +
+	"\tmagnitude\x18\x03 \x01(\x05R\tmagnitude\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x02R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x02R\tlongitude\"\xda\x01\n" +
+	"\x11IntentoAsignacion\x12\x17\n" +
+	"\adron_id\x18\x01 \x01(\tR\x06dronId\x12\x16\n" +
+	"\x06numero\x18\x02 \x01(\x05R\x06numero\x122\n" +
+	"\x06inicio\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06inicio\x12,\n" +
+	"\x03fin\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03fin\x12\x1c\n" +
+	"\tresultado\x18\x05 \x01(\tR\tresultado\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xe2\x05\n" +
+	"\x14EmergenciaRegistrada\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x02R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x02R\tlongitude\x12\x1c\n" +
+	"\tmagnitude\x18\x05 \x01(\x05R\tmagnitude\x12\x1a\n" +
+	"\x06status\x18\x06 \x01(\tB\x02\x18\x01R\x06status\x12\x17\n" +
+	"\adron_id\x18\a \x01(\tR\x06dronId\x12;\n" +
+	"\vreported_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reportedAt\x12-\n" +
+	"\x12motivo_cancelacion\x18\t \x01(\tR\x11motivoCancelacion\x129\n" +
+	"\bintentos\x18\n" +
+	" \x03(\v2\x1d.emergencia.IntentoAsignacionR\bintentos\x124\n" +
+	"\x06estado\x18\v \x01(\x0e2\x1c.emergencia.EstadoEmergenciaR\x06estado\x12;\n" +
+	"\vassigned_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x129\n" +
+	"\n" +
+	"arrived_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tarrivedAt\x12C\n" +
+	"\x0fextinguished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0eextinguishedAt\x12=\n" +
+	"\fcancelled_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12-\n" +
+	"\x12clave_idempotencia\x18\x10 \x01(\tR\x11claveIdempotencia\"9\n" +
+	"\x14GetEmergenciaRequest\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\"\xf5\x02\n" +
+	"\x16ListEmergenciasRequest\x12\x1a\n" +
+	"\x06status\x18\x01 \x01(\tB\x02\x18\x01R\x06status\x12!\n" +
+	"\fmagnitud_min\x18\x02 \x01(\x05R\vmagnitudMin\x12!\n" +
+	"\fmagnitud_max\x18\x03 \x01(\x05R\vmagnitudMax\x12\x17\n" +
+	"\adron_id\x18\x04 \x01(\tR\x06dronId\x120\n" +
+	"\x05desde\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05desde\x120\n" +
+	"\x05hasta\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05hasta\x12#\n" +
+	"\rtamano_pagina\x18\a \x01(\x05R\ftamanoPagina\x12!\n" +
+	"\ftoken_pagina\x18\b \x01(\tR\vtokenPagina\x124\n" +
+	"\x06estado\x18\t \x01(\x0e2\x1c.emergencia.EstadoEmergenciaR\x06estado\"\x9c\x01\n" +
+	"\x17ListEmergenciasResponse\x12B\n" +
+	"\vemergencias\x18\x01 \x03(\v2 .emergencia.EmergenciaRegistradaR\vemergencias\x12'\n" +
+	"\x0fsiguiente_token\x18\x02 \x01(\tR\x0esiguienteToken\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total*\x96\x01\n" +
+	"\x10EstadoEmergencia\x12\x1a\n" +
+	"\x16EMERGENCIA_DESCONOCIDA\x10\x00\x12\x18\n" +
+	"\x14EMERGENCIA_PENDIENTE\x10\x01\x12\x17\n" +
+	"\x13EMERGENCIA_EN_CURSO\x10\x02\x12\x19\n" +
+	"\x15EMERGENCIA_EXTINGUIDA\x10\x03\x12\x18\n" +
+	"\x14EMERGENCIA_CANCELADA\x10\x04*q\n" +
+	"\n" +
+	"EstadoDron\x12\x14\n" +
+	"\x10DRON_DESCONOCIDO\x10\x00\x12\x13\n" +
+	"\x0fDRON_DISPONIBLE\x10\x01\x12\x11\n" +
+	"\rDRON_ASIGNADO\x10\x02\x12\x12\n" +
+	"\x0eDRON_EN_MISION\x10\x03\x12\x11\n" +
+	"\rDRON_AVERIADO\x10\x04*\xdd\x01\n" +
+	"\n" +
+	"TipoEvento\x12\x16\n" +
+	"\x12EVENTO_DESCONOCIDO\x10\x00\x12\x13\n" +
+	"\x0fEVENTO_ASIGNADO\x10\x01\x12\x14\n" +
+	"\x10EVENTO_EN_CAMINO\x10\x02\x12\x13\n" +
+	"\x0fEVENTO_APAGANDO\x10\x03\x12\x15\n" +
+	"\x11EVENTO_EXTINGUIDA\x10\x04\x12\x14\n" +
+	"\x10EVENTO_CANCELADA\x10\x05\x12\x13\n" +
+	"\x0fEVENTO_ABORTADA\x10\x06\x12\x16\n" +
+	"\x12EVENTO_ACTUALIZADA\x10\a\x12\x1d\n" +
+	"\x19EVENTO_REFUERZO_TERMINADO\x10\b2\xe2\x03\n" +
 	"\tAsignador\x12J\n" +
-	"\x11EnviarEmergencias\x12\x1e.emergencia.EmergenciasRequest\x1a\x15.emergencia.Respuesta2R\n" +
+	"\x11EnviarEmergencias\x12\x1e.emergencia.EmergenciasRequest\x1a\x15.emergencia.Respuesta\x12R\n" +
+	"\x17EnviarEmergenciasStream\x12\x16.emergencia.Emergencia\x1a\x1b.emergencia.AcuseEmergencia(\x010\x01\x12:\n" +
+	"\rConsultarCola\x12\x11.emergencia.Vacio\x1a\x16.emergencia.EstadoCola\x12H\n" +
+	"\x12CancelarEmergencia\x12\x1b.emergencia.CancelarRequest\x1a\x15.emergencia.Respuesta\x12S\n" +
+	"\rGetEmergencia\x12 .emergencia.GetEmergenciaRequest\x1a .emergencia.EmergenciaRegistrada\x12Z\n" +
+	"\x0fListEmergencias\x12\".emergencia.ListEmergenciasRequest\x1a#.emergencia.ListEmergenciasResponse2\xe6\x01\n" +
 	"\x04Dron\x12J\n" +
-	"\x11AtenderEmergencia\x12\x1e.emergencia.EmergenciaAsignada\x1a\x15.emergencia.Respuesta2P\n" +
+	"\x11AtenderEmergencia\x12\x1e.emergencia.EmergenciaAsignada\x1a\x15.emergencia.Respuesta\x12B\n" +
+	"\rAbortarMision\x12\x1a.emergencia.AbortarRequest\x1a\x15.emergencia.Respuesta\x12N\n" +
+	"\x10ActualizarMision\x12#.emergencia.ActualizarMisionRequest\x1a\x15.emergencia.Respuesta2P\n" +
 	"\tMonitoreo\x12C\n" +
-	"\x0eStreamMensajes\x12\x11.emergencia.Vacio\x1a\x1c.emergencia.MensajeMonitoreo0\x01B\x0eZ\f./emergenciab\x06proto3"
+	"\x0eStreamMensajes\x12\x11.emergencia.Vacio\x1a\x1c.emergencia.MensajeMonitoreo0\x012\xd3\x02\n" +
+	"\x05Flota\x12F\n" +
+	"\fRegisterDron\x12 .emergencia.RegistrarDronRequest\x1a\x14.emergencia.InfoDron\x12@\n" +
+	"\x0eDeregisterDron\x12\x17.emergencia.DronRequest\x1a\x15.emergencia.Respuesta\x128\n" +
+	"\n" +
+	"ListDrones\x12\x11.emergencia.Vacio\x1a\x17.emergencia.ListaDrones\x128\n" +
+	"\aGetDron\x12\x17.emergencia.DronRequest\x1a\x14.emergencia.InfoDron\x12L\n" +
+	"\x12SetDronMaintenance\x12 .emergencia.MantenimientoRequest\x1a\x14.emergencia.InfoDronB\x0eZ\f./emergenciab\x06proto3"
 
 var (
 	file_emergencia_proto_rawDescOnce sync.Once
@@ -387,28 +2247,106 @@ func file_emergencia_proto_rawDescGZIP() []byte {
 	return file_emergencia_proto_rawDescData
 }
 
-var file_emergencia_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_emergencia_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_emergencia_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_emergencia_proto_goTypes = []any{
-	(*Emergencia)(nil),         // 0: emergencia.Emergencia
-	(*EmergenciasRequest)(nil), // 1: emergencia.EmergenciasRequest
-	(*EmergenciaAsignada)(nil), // 2: emergencia.EmergenciaAsignada
-	(*Respuesta)(nil),          // 3: emergencia.Respuesta
-	(*MensajeMonitoreo)(nil),   // 4: emergencia.MensajeMonitoreo
-	(*Vacio)(nil),              // 5: emergencia.Vacio
+	(EstadoEmergencia)(0),           // 0: emergencia.EstadoEmergencia
+	(EstadoDron)(0),                 // 1: emergencia.EstadoDron
+	(TipoEvento)(0),                 // 2: emergencia.TipoEvento
+	(*Emergencia)(nil),              // 3: emergencia.Emergencia
+	(*EmergenciasRequest)(nil),      // 4: emergencia.EmergenciasRequest
+	(*EmergenciaAsignada)(nil),      // 5: emergencia.EmergenciaAsignada
+	(*Respuesta)(nil),               // 6: emergencia.Respuesta
+	(*EventoMonitoreo)(nil),         // 7: emergencia.EventoMonitoreo
+	(*MensajeMonitoreo)(nil),        // 8: emergencia.MensajeMonitoreo
+	(*SaltoMonitoreo)(nil),          // 9: emergencia.SaltoMonitoreo
+	(*Vacio)(nil),                   // 10: emergencia.Vacio
+	(*InfoDron)(nil),                // 11: emergencia.InfoDron
+	(*RegistrarDronRequest)(nil),    // 12: emergencia.RegistrarDronRequest
+	(*DronRequest)(nil),             // 13: emergencia.DronRequest
+	(*MantenimientoRequest)(nil),    // 14: emergencia.MantenimientoRequest
+	(*ListaDrones)(nil),             // 15: emergencia.ListaDrones
+	(*EmergenciaEnCola)(nil),        // 16: emergencia.EmergenciaEnCola
+	(*EstadoCola)(nil),              // 17: emergencia.EstadoCola
+	(*AcuseEmergencia)(nil),         // 18: emergencia.AcuseEmergencia
+	(*CancelarRequest)(nil),         // 19: emergencia.CancelarRequest
+	(*AbortarRequest)(nil),          // 20: emergencia.AbortarRequest
+	(*ActualizarMisionRequest)(nil), // 21: emergencia.ActualizarMisionRequest
+	(*IntentoAsignacion)(nil),       // 22: emergencia.IntentoAsignacion
+	(*EmergenciaRegistrada)(nil),    // 23: emergencia.EmergenciaRegistrada
+	(*GetEmergenciaRequest)(nil),    // 24: emergencia.GetEmergenciaRequest
+	(*ListEmergenciasRequest)(nil),  // 25: emergencia.ListEmergenciasRequest
+	(*ListEmergenciasResponse)(nil), // 26: emergencia.ListEmergenciasResponse
+	(*timestamppb.Timestamp)(nil),   // 27: google.protobuf.Timestamp
 }
 var file_emergencia_proto_depIdxs = []int32{
-	0, // 0: emergencia.EmergenciasRequest.emergencias:type_name -> emergencia.Emergencia
-	1, // 1: emergencia.Asignador.EnviarEmergencias:input_type -> emergencia.EmergenciasRequest
-	2, // 2: emergencia.Dron.AtenderEmergencia:input_type -> emergencia.EmergenciaAsignada
-	5, // 3: emergencia.Monitoreo.StreamMensajes:input_type -> emergencia.Vacio
-	3, // 4: emergencia.Asignador.EnviarEmergencias:output_type -> emergencia.Respuesta
-	3, // 5: emergencia.Dron.AtenderEmergencia:output_type -> emergencia.Respuesta
-	4, // 6: emergencia.Monitoreo.StreamMensajes:output_type -> emergencia.MensajeMonitoreo
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: emergencia.EmergenciasRequest.emergencias:type_name -> emergencia.Emergencia
+	0,  // 1: emergencia.EmergenciaAsignada.estado:type_name -> emergencia.EstadoEmergencia
+	27, // 2: emergencia.EmergenciaAsignada.reported_at:type_name -> google.protobuf.Timestamp
+	27, // 3: emergencia.EmergenciaAsignada.assigned_at:type_name -> google.protobuf.Timestamp
+	27, // 4: emergencia.EmergenciaAsignada.arrived_at:type_name -> google.protobuf.Timestamp
+	27, // 5: emergencia.EmergenciaAsignada.extinguished_at:type_name -> google.protobuf.Timestamp
+	16, // 6: emergencia.Respuesta.encoladas:type_name -> emergencia.EmergenciaEnCola
+	2,  // 7: emergencia.EventoMonitoreo.tipo:type_name -> emergencia.TipoEvento
+	27, // 8: emergencia.EventoMonitoreo.timestamp:type_name -> google.protobuf.Timestamp
+	27, // 9: emergencia.EventoMonitoreo.assigned_at:type_name -> google.protobuf.Timestamp
+	27, // 10: emergencia.EventoMonitoreo.arrived_at:type_name -> google.protobuf.Timestamp
+	27, // 11: emergencia.EventoMonitoreo.extinguished_at:type_name -> google.protobuf.Timestamp
+	27, // 12: emergencia.EventoMonitoreo.reported_at:type_name -> google.protobuf.Timestamp
+	27, // 13: emergencia.MensajeMonitoreo.timestamp:type_name -> google.protobuf.Timestamp
+	7,  // 14: emergencia.MensajeMonitoreo.evento:type_name -> emergencia.EventoMonitoreo
+	9,  // 15: emergencia.MensajeMonitoreo.salto:type_name -> emergencia.SaltoMonitoreo
+	1,  // 16: emergencia.InfoDron.estado:type_name -> emergencia.EstadoDron
+	11, // 17: emergencia.ListaDrones.drones:type_name -> emergencia.InfoDron
+	16, // 18: emergencia.EstadoCola.emergencias:type_name -> emergencia.EmergenciaEnCola
+	27, // 19: emergencia.IntentoAsignacion.inicio:type_name -> google.protobuf.Timestamp
+	27, // 20: emergencia.IntentoAsignacion.fin:type_name -> google.protobuf.Timestamp
+	27, // 21: emergencia.EmergenciaRegistrada.reported_at:type_name -> google.protobuf.Timestamp
+	22, // 22: emergencia.EmergenciaRegistrada.intentos:type_name -> emergencia.IntentoAsignacion
+	0,  // 23: emergencia.EmergenciaRegistrada.estado:type_name -> emergencia.EstadoEmergencia
+	27, // 24: emergencia.EmergenciaRegistrada.assigned_at:type_name -> google.protobuf.Timestamp
+	27, // 25: emergencia.EmergenciaRegistrada.arrived_at:type_name -> google.protobuf.Timestamp
+	27, // 26: emergencia.EmergenciaRegistrada.extinguished_at:type_name -> google.protobuf.Timestamp
+	27, // 27: emergencia.EmergenciaRegistrada.cancelled_at:type_name -> google.protobuf.Timestamp
+	27, // 28: emergencia.ListEmergenciasRequest.desde:type_name -> google.protobuf.Timestamp
+	27, // 29: emergencia.ListEmergenciasRequest.hasta:type_name -> google.protobuf.Timestamp
+	0,  // 30: emergencia.ListEmergenciasRequest.estado:type_name -> emergencia.EstadoEmergencia
+	23, // 31: emergencia.ListEmergenciasResponse.emergencias:type_name -> emergencia.EmergenciaRegistrada
+	4,  // 32: emergencia.Asignador.EnviarEmergencias:input_type -> emergencia.EmergenciasRequest
+	3,  // 33: emergencia.Asignador.EnviarEmergenciasStream:input_type -> emergencia.Emergencia
+	10, // 34: emergencia.Asignador.ConsultarCola:input_type -> emergencia.Vacio
+	19, // 35: emergencia.Asignador.CancelarEmergencia:input_type -> emergencia.CancelarRequest
+	24, // 36: emergencia.Asignador.GetEmergencia:input_type -> emergencia.GetEmergenciaRequest
+	25, // 37: emergencia.Asignador.ListEmergencias:input_type -> emergencia.ListEmergenciasRequest
+	5,  // 38: emergencia.Dron.AtenderEmergencia:input_type -> emergencia.EmergenciaAsignada
+	20, // 39: emergencia.Dron.AbortarMision:input_type -> emergencia.AbortarRequest
+	21, // 40: emergencia.Dron.ActualizarMision:input_type -> emergencia.ActualizarMisionRequest
+	10, // 41: emergencia.Monitoreo.StreamMensajes:input_type -> emergencia.Vacio
+	12, // 42: emergencia.Flota.RegisterDron:input_type -> emergencia.RegistrarDronRequest
+	13, // 43: emergencia.Flota.DeregisterDron:input_type -> emergencia.DronRequest
+	10, // 44: emergencia.Flota.ListDrones:input_type -> emergencia.Vacio
+	13, // 45: emergencia.Flota.GetDron:input_type -> emergencia.DronRequest
+	14, // 46: emergencia.Flota.SetDronMaintenance:input_type -> emergencia.MantenimientoRequest
+	6,  // 47: emergencia.Asignador.EnviarEmergencias:output_type -> emergencia.Respuesta
+	18, // 48: emergencia.Asignador.EnviarEmergenciasStream:output_type -> emergencia.AcuseEmergencia
+	17, // 49: emergencia.Asignador.ConsultarCola:output_type -> emergencia.EstadoCola
+	6,  // 50: emergencia.Asignador.CancelarEmergencia:output_type -> emergencia.Respuesta
+	23, // 51: emergencia.Asignador.GetEmergencia:output_type -> emergencia.EmergenciaRegistrada
+	26, // 52: emergencia.Asignador.ListEmergencias:output_type -> emergencia.ListEmergenciasResponse
+	6,  // 53: emergencia.Dron.AtenderEmergencia:output_type -> emergencia.Respuesta
+	6,  // 54: emergencia.Dron.AbortarMision:output_type -> emergencia.Respuesta
+	6,  // 55: emergencia.Dron.ActualizarMision:output_type -> emergencia.Respuesta
+	8,  // 56: emergencia.Monitoreo.StreamMensajes:output_type -> emergencia.MensajeMonitoreo
+	11, // 57: emergencia.Flota.RegisterDron:output_type -> emergencia.InfoDron
+	6,  // 58: emergencia.Flota.DeregisterDron:output_type -> emergencia.Respuesta
+	15, // 59: emergencia.Flota.ListDrones:output_type -> emergencia.ListaDrones
+	11, // 60: emergencia.Flota.GetDron:output_type -> emergencia.InfoDron
+	11, // 61: emergencia.Flota.SetDronMaintenance:output_type -> emergencia.InfoDron
+	47, // [47:62] is the sub-list for method output_type
+	32, // [32:47] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_emergencia_proto_init() }
@@ -421,13 +2359,14 @@ func file_emergencia_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_emergencia_proto_rawDesc), len(file_emergencia_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   24,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   4,
 		},
 		GoTypes:           file_emergencia_proto_goTypes,
 		DependencyIndexes: file_emergencia_proto_depIdxs,
+		EnumInfos:         file_emergencia_proto_enumTypes,
 		MessageInfos:      file_emergencia_proto_msgTypes,
 	}.Build()
 	File_emergencia_proto = out.File
@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Asignador_EnviarEmergencias_FullMethodName = "/emergencia.Asignador/EnviarEmergencias"
+	Asignador_EnviarEmergencias_FullMethodName       = "/emergencia.Asignador/EnviarEmergencias"
+	Asignador_EnviarEmergenciasStream_FullMethodName = "/emergencia.Asignador/EnviarEmergenciasStream"
+	Asignador_ConsultarCola_FullMethodName           = "/emergencia.Asignador/ConsultarCola"
+	Asignador_CancelarEmergencia_FullMethodName      = "/emergencia.Asignador/CancelarEmergencia"
+	Asignador_GetEmergencia_FullMethodName           = "/emergencia.Asignador/GetEmergencia"
+	Asignador_ListEmergencias_FullMethodName         = "/emergencia.Asignador/ListEmergencias"
 )
 
 // AsignadorClient is the client API for Asignador service.
@@ -27,6 +32,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AsignadorClient interface {
 	EnviarEmergencias(ctx context.Context, in *EmergenciasRequest, opts ...grpc.CallOption) (*Respuesta, error)
+	EnviarEmergenciasStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Emergencia, AcuseEmergencia], error)
+	ConsultarCola(ctx context.Context, in *Vacio, opts ...grpc.CallOption) (*EstadoCola, error)
+	CancelarEmergencia(ctx context.Context, in *CancelarRequest, opts ...grpc.CallOption) (*Respuesta, error)
+	GetEmergencia(ctx context.Context, in *GetEmergenciaRequest, opts ...grpc.CallOption) (*EmergenciaRegistrada, error)
+	ListEmergencias(ctx context.Context, in *ListEmergenciasRequest, opts ...grpc.CallOption) (*ListEmergenciasResponse, error)
 }
 
 type asignadorClient struct {
@@ -47,11 +57,69 @@ func (c *asignadorClient) EnviarEmergencias(ctx context.Context, in *Emergencias
 	return out, nil
 }
 
+func (c *asignadorClient) EnviarEmergenciasStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Emergencia, AcuseEmergencia], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Asignador_ServiceDesc.Streams[0], Asignador_EnviarEmergenciasStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Emergencia, AcuseEmergencia]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Asignador_EnviarEmergenciasStreamClient = grpc.BidiStreamingClient[Emergencia, AcuseEmergencia]
+
+func (c *asignadorClient) ConsultarCola(ctx context.Context, in *Vacio, opts ...grpc.CallOption) (*EstadoCola, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EstadoCola)
+	err := c.cc.Invoke(ctx, Asignador_ConsultarCola_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) CancelarEmergencia(ctx context.Context, in *CancelarRequest, opts ...grpc.CallOption) (*Respuesta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Respuesta)
+	err := c.cc.Invoke(ctx, Asignador_CancelarEmergencia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) GetEmergencia(ctx context.Context, in *GetEmergenciaRequest, opts ...grpc.CallOption) (*EmergenciaRegistrada, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergenciaRegistrada)
+	err := c.cc.Invoke(ctx, Asignador_GetEmergencia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) ListEmergencias(ctx context.Context, in *ListEmergenciasRequest, opts ...grpc.CallOption) (*ListEmergenciasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmergenciasResponse)
+	err := c.cc.Invoke(ctx, Asignador_ListEmergencias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsignadorServer is the server API for Asignador service.
 // All implementations must embed UnimplementedAsignadorServer
 // for forward compatibility.
 type AsignadorServer interface {
 	EnviarEmergencias(context.Context, *EmergenciasRequest) (*Respuesta, error)
+	EnviarEmergenciasStream(grpc.BidiStreamingServer[Emergencia, AcuseEmergencia]) error
+	ConsultarCola(context.Context, *Vacio) (*EstadoCola, error)
+	CancelarEmergencia(context.Context, *CancelarRequest) (*Respuesta, error)
+	GetEmergencia(context.Context, *GetEmergenciaRequest) (*EmergenciaRegistrada, error)
+	ListEmergencias(context.Context, *ListEmergenciasRequest) (*ListEmergenciasResponse, error)
 	mustEmbedUnimplementedAsignadorServer()
 }
 
@@ -65,6 +133,21 @@ type UnimplementedAsignadorServer struct{}
 func (UnimplementedAsignadorServer) EnviarEmergencias(context.Context, *EmergenciasRequest) (*Respuesta, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnviarEmergencias not implemented")
 }
+func (UnimplementedAsignadorServer) EnviarEmergenciasStream(grpc.BidiStreamingServer[Emergencia, AcuseEmergencia]) error {
+	return status.Errorf(codes.Unimplemented, "method EnviarEmergenciasStream not implemented")
+}
+func (UnimplementedAsignadorServer) ConsultarCola(context.Context, *Vacio) (*EstadoCola, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsultarCola not implemented")
+}
+func (UnimplementedAsignadorServer) CancelarEmergencia(context.Context, *CancelarRequest) (*Respuesta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelarEmergencia not implemented")
+}
+func (UnimplementedAsignadorServer) GetEmergencia(context.Context, *GetEmergenciaRequest) (*EmergenciaRegistrada, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmergencia not implemented")
+}
+func (UnimplementedAsignadorServer) ListEmergencias(context.Context, *ListEmergenciasRequest) (*ListEmergenciasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencias not implemented")
+}
 func (UnimplementedAsignadorServer) mustEmbedUnimplementedAsignadorServer() {}
 func (UnimplementedAsignadorServer) testEmbeddedByValue()                   {}
 
@@ -104,6 +187,85 @@ func _Asignador_EnviarEmergencias_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Asignador_EnviarEmergenciasStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AsignadorServer).EnviarEmergenciasStream(&grpc.GenericServerStream[Emergencia, AcuseEmergencia]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Asignador_EnviarEmergenciasStreamServer = grpc.BidiStreamingServer[Emergencia, AcuseEmergencia]
+
+func _Asignador_ConsultarCola_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vacio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).ConsultarCola(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_ConsultarCola_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).ConsultarCola(ctx, req.(*Vacio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_CancelarEmergencia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).CancelarEmergencia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_CancelarEmergencia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).CancelarEmergencia(ctx, req.(*CancelarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_GetEmergencia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmergenciaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).GetEmergencia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_GetEmergencia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).GetEmergencia(ctx, req.(*GetEmergenciaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_ListEmergencias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergenciasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).ListEmergencias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_ListEmergencias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).ListEmergencias(ctx, req.(*ListEmergenciasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Asignador_ServiceDesc is the grpc.ServiceDesc for Asignador service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,13 +277,38 @@ var Asignador_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EnviarEmergencias",
 			Handler:    _Asignador_EnviarEmergencias_Handler,
 		},
+		{
+			MethodName: "ConsultarCola",
+			Handler:    _Asignador_ConsultarCola_Handler,
+		},
+		{
+			MethodName: "CancelarEmergencia",
+			Handler:    _Asignador_CancelarEmergencia_Handler,
+		},
+		{
+			MethodName: "GetEmergencia",
+			Handler:    _Asignador_GetEmergencia_Handler,
+		},
+		{
+			MethodName: "ListEmergencias",
+			Handler:    _Asignador_ListEmergencias_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EnviarEmergenciasStream",
+			Handler:       _Asignador_EnviarEmergenciasStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "emergencia.proto",
 }
 
 const (
 	Dron_AtenderEmergencia_FullMethodName = "/emergencia.Dron/AtenderEmergencia"
+	Dron_AbortarMision_FullMethodName     = "/emergencia.Dron/AbortarMision"
+	Dron_ActualizarMision_FullMethodName  = "/emergencia.Dron/ActualizarMision"
 )
 
 // DronClient is the client API for Dron service.
@@ -129,6 +316,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DronClient interface {
 	AtenderEmergencia(ctx context.Context, in *EmergenciaAsignada, opts ...grpc.CallOption) (*Respuesta, error)
+	AbortarMision(ctx context.Context, in *AbortarRequest, opts ...grpc.CallOption) (*Respuesta, error)
+	ActualizarMision(ctx context.Context, in *ActualizarMisionRequest, opts ...grpc.CallOption) (*Respuesta, error)
 }
 
 type dronClient struct {
@@ -149,11 +338,33 @@ func (c *dronClient) AtenderEmergencia(ctx context.Context, in *EmergenciaAsigna
 	return out, nil
 }
 
+func (c *dronClient) AbortarMision(ctx context.Context, in *AbortarRequest, opts ...grpc.CallOption) (*Respuesta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Respuesta)
+	err := c.cc.Invoke(ctx, Dron_AbortarMision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dronClient) ActualizarMision(ctx context.Context, in *ActualizarMisionRequest, opts ...grpc.CallOption) (*Respuesta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Respuesta)
+	err := c.cc.Invoke(ctx, Dron_ActualizarMision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DronServer is the server API for Dron service.
 // All implementations must embed UnimplementedDronServer
 // for forward compatibility.
 type DronServer interface {
 	AtenderEmergencia(context.Context, *EmergenciaAsignada) (*Respuesta, error)
+	AbortarMision(context.Context, *AbortarRequest) (*Respuesta, error)
+	ActualizarMision(context.Context, *ActualizarMisionRequest) (*Respuesta, error)
 	mustEmbedUnimplementedDronServer()
 }
 
@@ -167,6 +378,12 @@ type UnimplementedDronServer struct{}
 func (UnimplementedDronServer) AtenderEmergencia(context.Context, *EmergenciaAsignada) (*Respuesta, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AtenderEmergencia not implemented")
 }
+func (UnimplementedDronServer) AbortarMision(context.Context, *AbortarRequest) (*Respuesta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortarMision not implemented")
+}
+func (UnimplementedDronServer) ActualizarMision(context.Context, *ActualizarMisionRequest) (*Respuesta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualizarMision not implemented")
+}
 func (UnimplementedDronServer) mustEmbedUnimplementedDronServer() {}
 func (UnimplementedDronServer) testEmbeddedByValue()              {}
 
@@ -206,6 +423,42 @@ func _Dron_AtenderEmergencia_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dron_AbortarMision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DronServer).AbortarMision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dron_AbortarMision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DronServer).AbortarMision(ctx, req.(*AbortarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dron_ActualizarMision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualizarMisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DronServer).ActualizarMision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dron_ActualizarMision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DronServer).ActualizarMision(ctx, req.(*ActualizarMisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dron_ServiceDesc is the grpc.ServiceDesc for Dron service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +470,14 @@ var Dron_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AtenderEmergencia",
 			Handler:    _Dron_AtenderEmergencia_Handler,
 		},
+		{
+			MethodName: "AbortarMision",
+			Handler:    _Dron_AbortarMision_Handler,
+		},
+		{
+			MethodName: "ActualizarMision",
+			Handler:    _Dron_ActualizarMision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emergencia.proto",
@@ -326,3 +587,257 @@ var Monitoreo_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "emergencia.proto",
 }
+
+const (
+	Flota_RegisterDron_FullMethodName       = "/emergencia.Flota/RegisterDron"
+	Flota_DeregisterDron_FullMethodName     = "/emergencia.Flota/DeregisterDron"
+	Flota_ListDrones_FullMethodName         = "/emergencia.Flota/ListDrones"
+	Flota_GetDron_FullMethodName            = "/emergencia.Flota/GetDron"
+	Flota_SetDronMaintenance_FullMethodName = "/emergencia.Flota/SetDronMaintenance"
+)
+
+// FlotaClient is the client API for Flota service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FlotaClient interface {
+	RegisterDron(ctx context.Context, in *RegistrarDronRequest, opts ...grpc.CallOption) (*InfoDron, error)
+	DeregisterDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*Respuesta, error)
+	ListDrones(ctx context.Context, in *Vacio, opts ...grpc.CallOption) (*ListaDrones, error)
+	GetDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*InfoDron, error)
+	SetDronMaintenance(ctx context.Context, in *MantenimientoRequest, opts ...grpc.CallOption) (*InfoDron, error)
+}
+
+type flotaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlotaClient(cc grpc.ClientConnInterface) FlotaClient {
+	return &flotaClient{cc}
+}
+
+func (c *flotaClient) RegisterDron(ctx context.Context, in *RegistrarDronRequest, opts ...grpc.CallOption) (*InfoDron, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InfoDron)
+	err := c.cc.Invoke(ctx, Flota_RegisterDron_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) DeregisterDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*Respuesta, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Respuesta)
+	err := c.cc.Invoke(ctx, Flota_DeregisterDron_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) ListDrones(ctx context.Context, in *Vacio, opts ...grpc.CallOption) (*ListaDrones, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListaDrones)
+	err := c.cc.Invoke(ctx, Flota_ListDrones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) GetDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*InfoDron, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InfoDron)
+	err := c.cc.Invoke(ctx, Flota_GetDron_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) SetDronMaintenance(ctx context.Context, in *MantenimientoRequest, opts ...grpc.CallOption) (*InfoDron, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InfoDron)
+	err := c.cc.Invoke(ctx, Flota_SetDronMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FlotaServer is the server API for Flota service.
+// All implementations must embed UnimplementedFlotaServer
+// for forward compatibility.
+type FlotaServer interface {
+	RegisterDron(context.Context, *RegistrarDronRequest) (*InfoDron, error)
+	DeregisterDron(context.Context, *DronRequest) (*Respuesta, error)
+	ListDrones(context.Context, *Vacio) (*ListaDrones, error)
+	GetDron(context.Context, *DronRequest) (*InfoDron, error)
+	SetDronMaintenance(context.Context, *MantenimientoRequest) (*InfoDron, error)
+	mustEmbedUnimplementedFlotaServer()
+}
+
+// UnimplementedFlotaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFlotaServer struct{}
+
+func (UnimplementedFlotaServer) RegisterDron(context.Context, *RegistrarDronRequest) (*InfoDron, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDron not implemented")
+}
+func (UnimplementedFlotaServer) DeregisterDron(context.Context, *DronRequest) (*Respuesta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterDron not implemented")
+}
+func (UnimplementedFlotaServer) ListDrones(context.Context, *Vacio) (*ListaDrones, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrones not implemented")
+}
+func (UnimplementedFlotaServer) GetDron(context.Context, *DronRequest) (*InfoDron, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDron not implemented")
+}
+func (UnimplementedFlotaServer) SetDronMaintenance(context.Context, *MantenimientoRequest) (*InfoDron, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDronMaintenance not implemented")
+}
+func (UnimplementedFlotaServer) mustEmbedUnimplementedFlotaServer() {}
+func (UnimplementedFlotaServer) testEmbeddedByValue()               {}
+
+// UnsafeFlotaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlotaServer will
+// result in compilation errors.
+type UnsafeFlotaServer interface {
+	mustEmbedUnimplementedFlotaServer()
+}
+
+func RegisterFlotaServer(s grpc.ServiceRegistrar, srv FlotaServer) {
+	// If the following call pancis, it indicates UnimplementedFlotaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Flota_ServiceDesc, srv)
+}
+
+func _Flota_RegisterDron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrarDronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).RegisterDron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_RegisterDron_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).RegisterDron(ctx, req.(*RegistrarDronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_DeregisterDron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).DeregisterDron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_DeregisterDron_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).DeregisterDron(ctx, req.(*DronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_ListDrones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vacio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).ListDrones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_ListDrones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).ListDrones(ctx, req.(*Vacio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_GetDron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).GetDron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_GetDron_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).GetDron(ctx, req.(*DronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_SetDronMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MantenimientoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).SetDronMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_SetDronMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).SetDronMaintenance(ctx, req.(*MantenimientoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Flota_ServiceDesc is the grpc.ServiceDesc for Flota service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Flota_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emergencia.Flota",
+	HandlerType: (*FlotaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDron",
+			Handler:    _Flota_RegisterDron_Handler,
+		},
+		{
+			MethodName: "DeregisterDron",
+			Handler:    _Flota_DeregisterDron_Handler,
+		},
+		{
+			MethodName: "ListDrones",
+			Handler:    _Flota_ListDrones_Handler,
+		},
+		{
+			MethodName: "GetDron",
+			Handler:    _Flota_GetDron_Handler,
+		},
+		{
+			MethodName: "SetDronMaintenance",
+			Handler:    _Flota_SetDronMaintenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emergencia.proto",
+}
@@ -0,0 +1,48 @@
+package emergencia
+
+// textoEstadoEmergencia es el texto con que cada estado se guarda en la colección
+// emergencias, compartido con registro.py.
+var textoEstadoEmergencia = map[EstadoEmergencia]string{
+	EstadoEmergencia_EMERGENCIA_PENDIENTE:  "Pendiente",
+	EstadoEmergencia_EMERGENCIA_EN_CURSO:   "En curso",
+	EstadoEmergencia_EMERGENCIA_EXTINGUIDA: "Extinguido",
+	EstadoEmergencia_EMERGENCIA_CANCELADA:  "Cancelada",
+}
+
+// textoEstadoDron es el texto con que cada estado se guarda en la colección drones.
+var textoEstadoDron = map[EstadoDron]string{
+	EstadoDron_DRON_DISPONIBLE: "available",
+	EstadoDron_DRON_ASIGNADO:   "busy",
+	EstadoDron_DRON_EN_MISION:  "unavailable",
+	EstadoDron_DRON_AVERIADO:   "faulted",
+}
+
+// Texto devuelve el estado tal como se guarda en la colección emergencias.
+func (e EstadoEmergencia) Texto() string {
+	return textoEstadoEmergencia[e]
+}
+
+// EstadoEmergenciaDesdeTexto interpreta el estado guardado en la colección emergencias.
+func EstadoEmergenciaDesdeTexto(texto string) EstadoEmergencia {
+	for e, t := range textoEstadoEmergencia {
+		if t == texto {
+			return e
+		}
+	}
+	return EstadoEmergencia_EMERGENCIA_DESCONOCIDA
+}
+
+// Texto devuelve el estado tal como se guarda en la colección drones.
+func (e EstadoDron) Texto() string {
+	return textoEstadoDron[e]
+}
+
+// EstadoDronDesdeTexto interpreta el estado guardado en la colección drones.
+func EstadoDronDesdeTexto(texto string) EstadoDron {
+	for e, t := range textoEstadoDron {
+		if t == texto {
+			return e
+		}
+	}
+	return EstadoDron_DRON_DESCONOCIDO
+}
@@ -0,0 +1,33 @@
+package emergencia
+
+import "fmt"
+
+// Texto describe el evento en una frase para mostrar en el monitoreo.
+func (e *EventoMonitoreo) Texto() string {
+	avance := int(e.Progreso * 100)
+	switch e.Tipo {
+	case TipoEvento_EVENTO_ASIGNADO:
+		return fmt.Sprintf("Se ha asignado %s a la emergencia %s", e.DronId, e.Emergencia)
+	case TipoEvento_EVENTO_EN_CAMINO:
+		return fmt.Sprintf("%s en camino a %s (%d%%)", e.DronId, e.Emergencia, avance)
+	case TipoEvento_EVENTO_APAGANDO:
+		return fmt.Sprintf("%s apagando %s (%d%%)", e.DronId, e.Emergencia, avance)
+	case TipoEvento_EVENTO_EXTINGUIDA:
+		return fmt.Sprintf("%s ha sido extinguido por %s", e.Emergencia, e.DronId)
+	case TipoEvento_EVENTO_CANCELADA:
+		return fmt.Sprintf("%s ha sido cancelada", e.Emergencia)
+	case TipoEvento_EVENTO_ABORTADA:
+		return fmt.Sprintf("%s abandona %s y queda disponible", e.DronId, e.Emergencia)
+	case TipoEvento_EVENTO_ACTUALIZADA:
+		return fmt.Sprintf("%s cambió; %s recalcula su misión", e.Emergencia, e.DronId)
+	case TipoEvento_EVENTO_REFUERZO_TERMINADO:
+		return fmt.Sprintf("%s terminó su parte en %s y queda disponible", e.DronId, e.Emergencia)
+	}
+	return fmt.Sprintf("Evento %s de la emergencia %d", e.Tipo, e.EmergencyId)
+}
+
+// Terminal indica si el evento cierra la emergencia, ya sea porque se extinguió o porque
+// fue cancelada.
+func (e *EventoMonitoreo) Terminal() bool {
+	return e.Tipo == TipoEvento_EVENTO_EXTINGUIDA || e.Tipo == TipoEvento_EVENTO_CANCELADA
+}
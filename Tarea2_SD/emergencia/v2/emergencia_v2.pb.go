@@ -0,0 +1,2796 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.12.4
+// source: emergencia_v2.proto
+
+// Versión 2 del protocolo de los servicios Asignador, Flota y Monitoreo. Los mismos
+// servidores atienden también la versión 1 (emergencia.proto) traduciéndola a esta con los
+// adaptadores de emergencia/v2, para que los clientes ya desplegados sigan funcionando
+// mientras migran. El servicio Dron sigue definido solo en la versión 1.
+
+package emergenciav2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Ciclo de vida de una emergencia; mismos valores que en la versión 1
+type EstadoEmergencia int32
+
+const (
+	EstadoEmergencia_EMERGENCIA_DESCONOCIDA EstadoEmergencia = 0
+	EstadoEmergencia_EMERGENCIA_PENDIENTE   EstadoEmergencia = 1
+	EstadoEmergencia_EMERGENCIA_EN_CURSO    EstadoEmergencia = 2
+	EstadoEmergencia_EMERGENCIA_EXTINGUIDA  EstadoEmergencia = 3
+	EstadoEmergencia_EMERGENCIA_CANCELADA   EstadoEmergencia = 4
+)
+
+// Enum value maps for EstadoEmergencia.
+var (
+	EstadoEmergencia_name = map[int32]string{
+		0: "EMERGENCIA_DESCONOCIDA",
+		1: "EMERGENCIA_PENDIENTE",
+		2: "EMERGENCIA_EN_CURSO",
+		3: "EMERGENCIA_EXTINGUIDA",
+		4: "EMERGENCIA_CANCELADA",
+	}
+	EstadoEmergencia_value = map[string]int32{
+		"EMERGENCIA_DESCONOCIDA": 0,
+		"EMERGENCIA_PENDIENTE":   1,
+		"EMERGENCIA_EN_CURSO":    2,
+		"EMERGENCIA_EXTINGUIDA":  3,
+		"EMERGENCIA_CANCELADA":   4,
+	}
+)
+
+func (x EstadoEmergencia) Enum() *EstadoEmergencia {
+	p := new(EstadoEmergencia)
+	*p = x
+	return p
+}
+
+func (x EstadoEmergencia) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EstadoEmergencia) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_v2_proto_enumTypes[0].Descriptor()
+}
+
+func (EstadoEmergencia) Type() protoreflect.EnumType {
+	return &file_emergencia_v2_proto_enumTypes[0]
+}
+
+func (x EstadoEmergencia) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EstadoEmergencia.Descriptor instead.
+func (EstadoEmergencia) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{0}
+}
+
+// Estado de un dron; mismos valores que en la versión 1
+type EstadoDron int32
+
+const (
+	EstadoDron_DRON_DESCONOCIDO EstadoDron = 0
+	EstadoDron_DRON_DISPONIBLE  EstadoDron = 1
+	// Reservado por el asignador, aún sin despegar
+	EstadoDron_DRON_ASIGNADO  EstadoDron = 2
+	EstadoDron_DRON_EN_MISION EstadoDron = 3
+	EstadoDron_DRON_AVERIADO  EstadoDron = 4
+)
+
+// Enum value maps for EstadoDron.
+var (
+	EstadoDron_name = map[int32]string{
+		0: "DRON_DESCONOCIDO",
+		1: "DRON_DISPONIBLE",
+		2: "DRON_ASIGNADO",
+		3: "DRON_EN_MISION",
+		4: "DRON_AVERIADO",
+	}
+	EstadoDron_value = map[string]int32{
+		"DRON_DESCONOCIDO": 0,
+		"DRON_DISPONIBLE":  1,
+		"DRON_ASIGNADO":    2,
+		"DRON_EN_MISION":   3,
+		"DRON_AVERIADO":    4,
+	}
+)
+
+func (x EstadoDron) Enum() *EstadoDron {
+	p := new(EstadoDron)
+	*p = x
+	return p
+}
+
+func (x EstadoDron) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EstadoDron) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_v2_proto_enumTypes[1].Descriptor()
+}
+
+func (EstadoDron) Type() protoreflect.EnumType {
+	return &file_emergencia_v2_proto_enumTypes[1]
+}
+
+func (x EstadoDron) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EstadoDron.Descriptor instead.
+func (EstadoDron) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{1}
+}
+
+type TipoAcuse int32
+
+const (
+	TipoAcuse_ACUSE_DESCONOCIDO TipoAcuse = 0
+	TipoAcuse_ACUSE_ENCOLADA    TipoAcuse = 1
+	TipoAcuse_ACUSE_ASIGNADA    TipoAcuse = 2
+	TipoAcuse_ACUSE_CANCELADA   TipoAcuse = 3
+	// Repetía una clave_idempotencia; el acuse describe la emergencia original
+	TipoAcuse_ACUSE_DUPLICADA TipoAcuse = 4
+)
+
+// Enum value maps for TipoAcuse.
+var (
+	TipoAcuse_name = map[int32]string{
+		0: "ACUSE_DESCONOCIDO",
+		1: "ACUSE_ENCOLADA",
+		2: "ACUSE_ASIGNADA",
+		3: "ACUSE_CANCELADA",
+		4: "ACUSE_DUPLICADA",
+	}
+	TipoAcuse_value = map[string]int32{
+		"ACUSE_DESCONOCIDO": 0,
+		"ACUSE_ENCOLADA":    1,
+		"ACUSE_ASIGNADA":    2,
+		"ACUSE_CANCELADA":   3,
+		"ACUSE_DUPLICADA":   4,
+	}
+)
+
+func (x TipoAcuse) Enum() *TipoAcuse {
+	p := new(TipoAcuse)
+	*p = x
+	return p
+}
+
+func (x TipoAcuse) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TipoAcuse) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_v2_proto_enumTypes[2].Descriptor()
+}
+
+func (TipoAcuse) Type() protoreflect.EnumType {
+	return &file_emergencia_v2_proto_enumTypes[2]
+}
+
+func (x TipoAcuse) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TipoAcuse.Descriptor instead.
+func (TipoAcuse) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{2}
+}
+
+// Tipo de evento de una misión; mismos valores que en la versión 1
+type TipoEvento int32
+
+const (
+	TipoEvento_EVENTO_DESCONOCIDO TipoEvento = 0
+	// Un dron recibió la misión
+	TipoEvento_EVENTO_ASIGNADO TipoEvento = 1
+	// El dron vuela hacia la emergencia
+	TipoEvento_EVENTO_EN_CAMINO TipoEvento = 2
+	// El dron llegó y está apagando la emergencia
+	TipoEvento_EVENTO_APAGANDO   TipoEvento = 3
+	TipoEvento_EVENTO_EXTINGUIDA TipoEvento = 4
+	// La emergencia fue cancelada por el asignador
+	TipoEvento_EVENTO_CANCELADA TipoEvento = 5
+	// El dron abandonó una misión cancelada y queda disponible
+	TipoEvento_EVENTO_ABORTADA TipoEvento = 6
+	// La emergencia cambió de magnitud o de ubicación y el dron recalculó su misión
+	TipoEvento_EVENTO_ACTUALIZADA TipoEvento = 7
+	// Un dron de refuerzo terminó su parte y queda disponible
+	TipoEvento_EVENTO_REFUERZO_TERMINADO TipoEvento = 8
+)
+
+// Enum value maps for TipoEvento.
+var (
+	TipoEvento_name = map[int32]string{
+		0: "EVENTO_DESCONOCIDO",
+		1: "EVENTO_ASIGNADO",
+		2: "EVENTO_EN_CAMINO",
+		3: "EVENTO_APAGANDO",
+		4: "EVENTO_EXTINGUIDA",
+		5: "EVENTO_CANCELADA",
+		6: "EVENTO_ABORTADA",
+		7: "EVENTO_ACTUALIZADA",
+		8: "EVENTO_REFUERZO_TERMINADO",
+	}
+	TipoEvento_value = map[string]int32{
+		"EVENTO_DESCONOCIDO":        0,
+		"EVENTO_ASIGNADO":           1,
+		"EVENTO_EN_CAMINO":          2,
+		"EVENTO_APAGANDO":           3,
+		"EVENTO_EXTINGUIDA":         4,
+		"EVENTO_CANCELADA":          5,
+		"EVENTO_ABORTADA":           6,
+		"EVENTO_ACTUALIZADA":        7,
+		"EVENTO_REFUERZO_TERMINADO": 8,
+	}
+)
+
+func (x TipoEvento) Enum() *TipoEvento {
+	p := new(TipoEvento)
+	*p = x
+	return p
+}
+
+func (x TipoEvento) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TipoEvento) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_v2_proto_enumTypes[3].Descriptor()
+}
+
+func (TipoEvento) Type() protoreflect.EnumType {
+	return &file_emergencia_v2_proto_enumTypes[3]
+}
+
+func (x TipoEvento) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TipoEvento.Descriptor instead.
+func (TipoEvento) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{3}
+}
+
+// Forma en que StreamMensajes entrega los mensajes a un suscriptor
+type ModoEntrega int32
+
+const (
+	// Cada mensaje apenas llega
+	ModoEntrega_ENTREGA_TIEMPO_REAL ModoEntrega = 0
+	// A lo más mensajes_por_segundo mensajes por segundo
+	ModoEntrega_ENTREGA_LIMITADA ModoEntrega = 1
+	// Los mensajes acumulados, cada intervalo_lote
+	ModoEntrega_ENTREGA_POR_LOTES ModoEntrega = 2
+)
+
+// Enum value maps for ModoEntrega.
+var (
+	ModoEntrega_name = map[int32]string{
+		0: "ENTREGA_TIEMPO_REAL",
+		1: "ENTREGA_LIMITADA",
+		2: "ENTREGA_POR_LOTES",
+	}
+	ModoEntrega_value = map[string]int32{
+		"ENTREGA_TIEMPO_REAL": 0,
+		"ENTREGA_LIMITADA":    1,
+		"ENTREGA_POR_LOTES":   2,
+	}
+)
+
+func (x ModoEntrega) Enum() *ModoEntrega {
+	p := new(ModoEntrega)
+	*p = x
+	return p
+}
+
+func (x ModoEntrega) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModoEntrega) Descriptor() protoreflect.EnumDescriptor {
+	return file_emergencia_v2_proto_enumTypes[4].Descriptor()
+}
+
+func (ModoEntrega) Type() protoreflect.EnumType {
+	return &file_emergencia_v2_proto_enumTypes[4]
+}
+
+func (x ModoEntrega) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModoEntrega.Descriptor instead.
+func (ModoEntrega) EnumDescriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{4}
+}
+
+type Emergencia struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Magnitude int32                  `protobuf:"varint,4,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	// Clave opcional elegida por el cliente. Si llega otra emergencia con la misma clave
+	// dentro de la ventana de idempotencia, el asignador devuelve la emergencia original en
+	// vez de crear una nueva.
+	ClaveIdempotencia string `protobuf:"bytes,5,opt,name=clave_idempotencia,json=claveIdempotencia,proto3" json:"clave_idempotencia,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Emergencia) Reset() {
+	*x = Emergencia{}
+	mi := &file_emergencia_v2_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Emergencia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Emergencia) ProtoMessage() {}
+
+func (x *Emergencia) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Emergencia.ProtoReflect.Descriptor instead.
+func (*Emergencia) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Emergencia) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Emergencia) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Emergencia) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Emergencia) GetMagnitude() int32 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *Emergencia) GetClaveIdempotencia() string {
+	if x != nil {
+		return x.ClaveIdempotencia
+	}
+	return ""
+}
+
+type EnviarEmergenciasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emergencias   []*Emergencia          `protobuf:"bytes,1,rep,name=emergencias,proto3" json:"emergencias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnviarEmergenciasRequest) Reset() {
+	*x = EnviarEmergenciasRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnviarEmergenciasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnviarEmergenciasRequest) ProtoMessage() {}
+
+func (x *EnviarEmergenciasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnviarEmergenciasRequest.ProtoReflect.Descriptor instead.
+func (*EnviarEmergenciasRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnviarEmergenciasRequest) GetEmergencias() []*Emergencia {
+	if x != nil {
+		return x.Emergencias
+	}
+	return nil
+}
+
+type EnviarEmergenciasResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Una entrada por emergencia enviada, en el mismo orden
+	Encoladas     []*EmergenciaEnCola `protobuf:"bytes,1,rep,name=encoladas,proto3" json:"encoladas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnviarEmergenciasResponse) Reset() {
+	*x = EnviarEmergenciasResponse{}
+	mi := &file_emergencia_v2_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnviarEmergenciasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnviarEmergenciasResponse) ProtoMessage() {}
+
+func (x *EnviarEmergenciasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnviarEmergenciasResponse.ProtoReflect.Descriptor instead.
+func (*EnviarEmergenciasResponse) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnviarEmergenciasResponse) GetEncoladas() []*EmergenciaEnCola {
+	if x != nil {
+		return x.Encoladas
+	}
+	return nil
+}
+
+// Emergencia que espera en la cola de despacho del asignador
+type EmergenciaEnCola struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Magnitude   int32                  `protobuf:"varint,3,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	// Posición en la cola, empezando en 1; 0 si ya salió de ella
+	Posicion  int32                `protobuf:"varint,4,opt,name=posicion,proto3" json:"posicion,omitempty"`
+	Espera    *durationpb.Duration `protobuf:"bytes,5,opt,name=espera,proto3" json:"espera,omitempty"`
+	Prioridad float64              `protobuf:"fixed64,6,opt,name=prioridad,proto3" json:"prioridad,omitempty"`
+	Motivo    string               `protobuf:"bytes,7,opt,name=motivo,proto3" json:"motivo,omitempty"`
+	// Dron asignado, si la emergencia ya salió de la cola
+	DronId string `protobuf:"bytes,8,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	// La emergencia repetía una clave_idempotencia ya recibida y no se volvió a encolar
+	Duplicada     bool `protobuf:"varint,9,opt,name=duplicada,proto3" json:"duplicada,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmergenciaEnCola) Reset() {
+	*x = EmergenciaEnCola{}
+	mi := &file_emergencia_v2_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergenciaEnCola) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergenciaEnCola) ProtoMessage() {}
+
+func (x *EmergenciaEnCola) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergenciaEnCola.ProtoReflect.Descriptor instead.
+func (*EmergenciaEnCola) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EmergenciaEnCola) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmergenciaEnCola) GetMagnitude() int32 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetPosicion() int32 {
+	if x != nil {
+		return x.Posicion
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetEspera() *durationpb.Duration {
+	if x != nil {
+		return x.Espera
+	}
+	return nil
+}
+
+func (x *EmergenciaEnCola) GetPrioridad() float64 {
+	if x != nil {
+		return x.Prioridad
+	}
+	return 0
+}
+
+func (x *EmergenciaEnCola) GetMotivo() string {
+	if x != nil {
+		return x.Motivo
+	}
+	return ""
+}
+
+func (x *EmergenciaEnCola) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *EmergenciaEnCola) GetDuplicada() bool {
+	if x != nil {
+		return x.Duplicada
+	}
+	return false
+}
+
+type ConsultarColaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsultarColaRequest) Reset() {
+	*x = ConsultarColaRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsultarColaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsultarColaRequest) ProtoMessage() {}
+
+func (x *ConsultarColaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsultarColaRequest.ProtoReflect.Descriptor instead.
+func (*ConsultarColaRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{4}
+}
+
+type ConsultarColaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// En el orden en que serían despachadas
+	Emergencias   []*EmergenciaEnCola `protobuf:"bytes,1,rep,name=emergencias,proto3" json:"emergencias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsultarColaResponse) Reset() {
+	*x = ConsultarColaResponse{}
+	mi := &file_emergencia_v2_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsultarColaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsultarColaResponse) ProtoMessage() {}
+
+func (x *ConsultarColaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsultarColaResponse.ProtoReflect.Descriptor instead.
+func (*ConsultarColaResponse) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConsultarColaResponse) GetEmergencias() []*EmergenciaEnCola {
+	if x != nil {
+		return x.Emergencias
+	}
+	return nil
+}
+
+// Acuse de una emergencia enviada por EnviarEmergenciasStream. Cada emergencia recibe un
+// acuse al entrar a la cola y otro cuando se le asigna un dron o se cancela.
+type AcuseEmergencia struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Posición de la emergencia en el stream del cliente, empezando en 0
+	Indice      int32     `protobuf:"varint,1,opt,name=indice,proto3" json:"indice,omitempty"`
+	EmergencyId int32     `protobuf:"varint,2,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Tipo        TipoAcuse `protobuf:"varint,3,opt,name=tipo,proto3,enum=emergencia.v2.TipoAcuse" json:"tipo,omitempty"`
+	// Posición en la cola de despacho; 0 cuando ya salió de ella
+	Posicion int32  `protobuf:"varint,4,opt,name=posicion,proto3" json:"posicion,omitempty"`
+	DronId   string `protobuf:"bytes,5,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	// Explicación legible, p. ej. el estado de la emergencia original de una duplicada
+	Detalle       string `protobuf:"bytes,6,opt,name=detalle,proto3" json:"detalle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcuseEmergencia) Reset() {
+	*x = AcuseEmergencia{}
+	mi := &file_emergencia_v2_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcuseEmergencia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcuseEmergencia) ProtoMessage() {}
+
+func (x *AcuseEmergencia) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcuseEmergencia.ProtoReflect.Descriptor instead.
+func (*AcuseEmergencia) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcuseEmergencia) GetIndice() int32 {
+	if x != nil {
+		return x.Indice
+	}
+	return 0
+}
+
+func (x *AcuseEmergencia) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *AcuseEmergencia) GetTipo() TipoAcuse {
+	if x != nil {
+		return x.Tipo
+	}
+	return TipoAcuse_ACUSE_DESCONOCIDO
+}
+
+func (x *AcuseEmergencia) GetPosicion() int32 {
+	if x != nil {
+		return x.Posicion
+	}
+	return 0
+}
+
+func (x *AcuseEmergencia) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *AcuseEmergencia) GetDetalle() string {
+	if x != nil {
+		return x.Detalle
+	}
+	return ""
+}
+
+// Cambios a una emergencia pendiente o en curso; los campos ausentes no cambian
+type ActualizarEmergenciaRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Magnitude   *int32                 `protobuf:"varint,2,opt,name=magnitude,proto3,oneof" json:"magnitude,omitempty"`
+	// Latitud y longitud se cambian juntas
+	Latitude  *float64 `protobuf:"fixed64,3,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude *float64 `protobuf:"fixed64,4,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	// Si la magnitud sube y la emergencia está en curso, cantidad de drones adicionales que
+	// se despachan para apagar el aumento; con 0 el aumento lo apaga el dron asignado
+	Refuerzos     int32 `protobuf:"varint,5,opt,name=refuerzos,proto3" json:"refuerzos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActualizarEmergenciaRequest) Reset() {
+	*x = ActualizarEmergenciaRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActualizarEmergenciaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActualizarEmergenciaRequest) ProtoMessage() {}
+
+func (x *ActualizarEmergenciaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActualizarEmergenciaRequest.ProtoReflect.Descriptor instead.
+func (*ActualizarEmergenciaRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ActualizarEmergenciaRequest) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *ActualizarEmergenciaRequest) GetMagnitude() int32 {
+	if x != nil && x.Magnitude != nil {
+		return *x.Magnitude
+	}
+	return 0
+}
+
+func (x *ActualizarEmergenciaRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *ActualizarEmergenciaRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *ActualizarEmergenciaRequest) GetRefuerzos() int32 {
+	if x != nil {
+		return x.Refuerzos
+	}
+	return 0
+}
+
+type ActualizarEmergenciaResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId      int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	MagnitudAnterior int32                  `protobuf:"varint,2,opt,name=magnitud_anterior,json=magnitudAnterior,proto3" json:"magnitud_anterior,omitempty"`
+	Magnitude        int32                  `protobuf:"varint,3,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	// Drones en misión a los que se les recalculó la misión
+	DronesActualizados []string `protobuf:"bytes,4,rep,name=drones_actualizados,json=dronesActualizados,proto3" json:"drones_actualizados,omitempty"`
+	// Misiones de refuerzo que quedaron en la cola de despacho
+	RefuerzosEncolados int32 `protobuf:"varint,5,opt,name=refuerzos_encolados,json=refuerzosEncolados,proto3" json:"refuerzos_encolados,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ActualizarEmergenciaResponse) Reset() {
+	*x = ActualizarEmergenciaResponse{}
+	mi := &file_emergencia_v2_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActualizarEmergenciaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActualizarEmergenciaResponse) ProtoMessage() {}
+
+func (x *ActualizarEmergenciaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActualizarEmergenciaResponse.ProtoReflect.Descriptor instead.
+func (*ActualizarEmergenciaResponse) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ActualizarEmergenciaResponse) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *ActualizarEmergenciaResponse) GetMagnitudAnterior() int32 {
+	if x != nil {
+		return x.MagnitudAnterior
+	}
+	return 0
+}
+
+func (x *ActualizarEmergenciaResponse) GetMagnitude() int32 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *ActualizarEmergenciaResponse) GetDronesActualizados() []string {
+	if x != nil {
+		return x.DronesActualizados
+	}
+	return nil
+}
+
+func (x *ActualizarEmergenciaResponse) GetRefuerzosEncolados() int32 {
+	if x != nil {
+		return x.RefuerzosEncolados
+	}
+	return 0
+}
+
+type CancelarEmergenciaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId   int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Motivo        string                 `protobuf:"bytes,2,opt,name=motivo,proto3" json:"motivo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelarEmergenciaRequest) Reset() {
+	*x = CancelarEmergenciaRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelarEmergenciaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelarEmergenciaRequest) ProtoMessage() {}
+
+func (x *CancelarEmergenciaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelarEmergenciaRequest.ProtoReflect.Descriptor instead.
+func (*CancelarEmergenciaRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelarEmergenciaRequest) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *CancelarEmergenciaRequest) GetMotivo() string {
+	if x != nil {
+		return x.Motivo
+	}
+	return ""
+}
+
+type CancelarEmergenciaResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	// PENDIENTE si se retiró de la cola, EN_CURSO si se abortó una misión
+	EstadoAnterior EstadoEmergencia `protobuf:"varint,2,opt,name=estado_anterior,json=estadoAnterior,proto3,enum=emergencia.v2.EstadoEmergencia" json:"estado_anterior,omitempty"`
+	// Dron cuya misión se abortó
+	DronId        string `protobuf:"bytes,3,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelarEmergenciaResponse) Reset() {
+	*x = CancelarEmergenciaResponse{}
+	mi := &file_emergencia_v2_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelarEmergenciaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelarEmergenciaResponse) ProtoMessage() {}
+
+func (x *CancelarEmergenciaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelarEmergenciaResponse.ProtoReflect.Descriptor instead.
+func (*CancelarEmergenciaResponse) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelarEmergenciaResponse) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *CancelarEmergenciaResponse) GetEstadoAnterior() EstadoEmergencia {
+	if x != nil {
+		return x.EstadoAnterior
+	}
+	return EstadoEmergencia_EMERGENCIA_DESCONOCIDA
+}
+
+func (x *CancelarEmergenciaResponse) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+// Intento de entregar una emergencia a un dron
+type IntentoAsignacion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DronId        string                 `protobuf:"bytes,1,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	Numero        int32                  `protobuf:"varint,2,opt,name=numero,proto3" json:"numero,omitempty"`
+	Inicio        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=inicio,proto3" json:"inicio,omitempty"`
+	Fin           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=fin,proto3" json:"fin,omitempty"`
+	Resultado     string                 `protobuf:"bytes,5,opt,name=resultado,proto3" json:"resultado,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntentoAsignacion) Reset() {
+	*x = IntentoAsignacion{}
+	mi := &file_emergencia_v2_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntentoAsignacion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntentoAsignacion) ProtoMessage() {}
+
+func (x *IntentoAsignacion) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntentoAsignacion.ProtoReflect.Descriptor instead.
+func (*IntentoAsignacion) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IntentoAsignacion) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *IntentoAsignacion) GetNumero() int32 {
+	if x != nil {
+		return x.Numero
+	}
+	return 0
+}
+
+func (x *IntentoAsignacion) GetInicio() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Inicio
+	}
+	return nil
+}
+
+func (x *IntentoAsignacion) GetFin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fin
+	}
+	return nil
+}
+
+func (x *IntentoAsignacion) GetResultado() string {
+	if x != nil {
+		return x.Resultado
+	}
+	return ""
+}
+
+func (x *IntentoAsignacion) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Emergencia tal como está registrada en la colección emergencias
+type EmergenciaRegistrada struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId       int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude          float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude         float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Magnitude         int32                  `protobuf:"varint,5,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	Estado            EstadoEmergencia       `protobuf:"varint,6,opt,name=estado,proto3,enum=emergencia.v2.EstadoEmergencia" json:"estado,omitempty"`
+	DronId            string                 `protobuf:"bytes,7,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	ClaveIdempotencia string                 `protobuf:"bytes,8,opt,name=clave_idempotencia,json=claveIdempotencia,proto3" json:"clave_idempotencia,omitempty"`
+	MotivoCancelacion string                 `protobuf:"bytes,9,opt,name=motivo_cancelacion,json=motivoCancelacion,proto3" json:"motivo_cancelacion,omitempty"`
+	Intentos          []*IntentoAsignacion   `protobuf:"bytes,10,rep,name=intentos,proto3" json:"intentos,omitempty"`
+	ReportedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	AssignedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	ArrivedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=arrived_at,json=arrivedAt,proto3" json:"arrived_at,omitempty"`
+	ExtinguishedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=extinguished_at,json=extinguishedAt,proto3" json:"extinguished_at,omitempty"`
+	CancelledAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EmergenciaRegistrada) Reset() {
+	*x = EmergenciaRegistrada{}
+	mi := &file_emergencia_v2_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergenciaRegistrada) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergenciaRegistrada) ProtoMessage() {}
+
+func (x *EmergenciaRegistrada) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergenciaRegistrada.ProtoReflect.Descriptor instead.
+func (*EmergenciaRegistrada) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EmergenciaRegistrada) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *EmergenciaRegistrada) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *EmergenciaRegistrada) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *EmergenciaRegistrada) GetMagnitude() int32 {
+	if x != nil {
+		return x.Magnitude
+	}
+	return 0
+}
+
+func (x *EmergenciaRegistrada) GetEstado() EstadoEmergencia {
+	if x != nil {
+		return x.Estado
+	}
+	return EstadoEmergencia_EMERGENCIA_DESCONOCIDA
+}
+
+func (x *EmergenciaRegistrada) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetClaveIdempotencia() string {
+	if x != nil {
+		return x.ClaveIdempotencia
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetMotivoCancelacion() string {
+	if x != nil {
+		return x.MotivoCancelacion
+	}
+	return ""
+}
+
+func (x *EmergenciaRegistrada) GetIntentos() []*IntentoAsignacion {
+	if x != nil {
+		return x.Intentos
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetExtinguishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtinguishedAt
+	}
+	return nil
+}
+
+func (x *EmergenciaRegistrada) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+type GetEmergenciaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmergencyId   int32                  `protobuf:"varint,1,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmergenciaRequest) Reset() {
+	*x = GetEmergenciaRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmergenciaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmergenciaRequest) ProtoMessage() {}
+
+func (x *GetEmergenciaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmergenciaRequest.ProtoReflect.Descriptor instead.
+func (*GetEmergenciaRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetEmergenciaRequest) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+// Filtros de ListEmergencias; los campos vacíos o en cero no filtran
+type ListEmergenciasRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Emergencias en cualquiera de estos estados
+	Estados       []EstadoEmergencia     `protobuf:"varint,1,rep,packed,name=estados,proto3,enum=emergencia.v2.EstadoEmergencia" json:"estados,omitempty"`
+	MagnitudMin   int32                  `protobuf:"varint,2,opt,name=magnitud_min,json=magnitudMin,proto3" json:"magnitud_min,omitempty"`
+	MagnitudMax   int32                  `protobuf:"varint,3,opt,name=magnitud_max,json=magnitudMax,proto3" json:"magnitud_max,omitempty"`
+	DronId        string                 `protobuf:"bytes,4,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	Desde         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=desde,proto3" json:"desde,omitempty"`
+	Hasta         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=hasta,proto3" json:"hasta,omitempty"`
+	TamanoPagina  int32                  `protobuf:"varint,7,opt,name=tamano_pagina,json=tamanoPagina,proto3" json:"tamano_pagina,omitempty"`
+	TokenPagina   string                 `protobuf:"bytes,8,opt,name=token_pagina,json=tokenPagina,proto3" json:"token_pagina,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmergenciasRequest) Reset() {
+	*x = ListEmergenciasRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergenciasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergenciasRequest) ProtoMessage() {}
+
+func (x *ListEmergenciasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergenciasRequest.ProtoReflect.Descriptor instead.
+func (*ListEmergenciasRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListEmergenciasRequest) GetEstados() []EstadoEmergencia {
+	if x != nil {
+		return x.Estados
+	}
+	return nil
+}
+
+func (x *ListEmergenciasRequest) GetMagnitudMin() int32 {
+	if x != nil {
+		return x.MagnitudMin
+	}
+	return 0
+}
+
+func (x *ListEmergenciasRequest) GetMagnitudMax() int32 {
+	if x != nil {
+		return x.MagnitudMax
+	}
+	return 0
+}
+
+func (x *ListEmergenciasRequest) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *ListEmergenciasRequest) GetDesde() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Desde
+	}
+	return nil
+}
+
+func (x *ListEmergenciasRequest) GetHasta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Hasta
+	}
+	return nil
+}
+
+func (x *ListEmergenciasRequest) GetTamanoPagina() int32 {
+	if x != nil {
+		return x.TamanoPagina
+	}
+	return 0
+}
+
+func (x *ListEmergenciasRequest) GetTokenPagina() string {
+	if x != nil {
+		return x.TokenPagina
+	}
+	return ""
+}
+
+type ListEmergenciasResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Emergencias    []*EmergenciaRegistrada `protobuf:"bytes,1,rep,name=emergencias,proto3" json:"emergencias,omitempty"`
+	SiguienteToken string                  `protobuf:"bytes,2,opt,name=siguiente_token,json=siguienteToken,proto3" json:"siguiente_token,omitempty"`
+	Total          int64                   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListEmergenciasResponse) Reset() {
+	*x = ListEmergenciasResponse{}
+	mi := &file_emergencia_v2_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmergenciasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmergenciasResponse) ProtoMessage() {}
+
+func (x *ListEmergenciasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmergenciasResponse.ProtoReflect.Descriptor instead.
+func (*ListEmergenciasResponse) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListEmergenciasResponse) GetEmergencias() []*EmergenciaRegistrada {
+	if x != nil {
+		return x.Emergencias
+	}
+	return nil
+}
+
+func (x *ListEmergenciasResponse) GetSiguienteToken() string {
+	if x != nil {
+		return x.SiguienteToken
+	}
+	return ""
+}
+
+func (x *ListEmergenciasResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Dron registrado en la colección drones
+type Dron struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Estado    EstadoDron             `protobuf:"varint,4,opt,name=estado,proto3,enum=emergencia.v2.EstadoDron" json:"estado,omitempty"`
+	// Dirección host:puerto del servicio de drones que lo atiende
+	Address       string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Mantenimiento bool   `protobuf:"varint,6,opt,name=mantenimiento,proto3" json:"mantenimiento,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dron) Reset() {
+	*x = Dron{}
+	mi := &file_emergencia_v2_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dron) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dron) ProtoMessage() {}
+
+func (x *Dron) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dron.ProtoReflect.Descriptor instead.
+func (*Dron) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Dron) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Dron) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Dron) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Dron) GetEstado() EstadoDron {
+	if x != nil {
+		return x.Estado
+	}
+	return EstadoDron_DRON_DESCONOCIDO
+}
+
+func (x *Dron) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Dron) GetMantenimiento() bool {
+	if x != nil {
+		return x.Mantenimiento
+	}
+	return false
+}
+
+type RegistrarDronRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegistrarDronRequest) Reset() {
+	*x = RegistrarDronRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistrarDronRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrarDronRequest) ProtoMessage() {}
+
+func (x *RegistrarDronRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrarDronRequest.ProtoReflect.Descriptor instead.
+func (*RegistrarDronRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegistrarDronRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegistrarDronRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *RegistrarDronRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *RegistrarDronRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DronRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DronRequest) Reset() {
+	*x = DronRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DronRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DronRequest) ProtoMessage() {}
+
+func (x *DronRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DronRequest.ProtoReflect.Descriptor instead.
+func (*DronRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DronRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListDronesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Solo los drones en este estado; DRON_DESCONOCIDO no filtra
+	Estado        EstadoDron `protobuf:"varint,1,opt,name=estado,proto3,enum=emergencia.v2.EstadoDron" json:"estado,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDronesRequest) Reset() {
+	*x = ListDronesRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDronesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDronesRequest) ProtoMessage() {}
+
+func (x *ListDronesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDronesRequest.ProtoReflect.Descriptor instead.
+func (*ListDronesRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDronesRequest) GetEstado() EstadoDron {
+	if x != nil {
+		return x.Estado
+	}
+	return EstadoDron_DRON_DESCONOCIDO
+}
+
+type ListDronesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drones        []*Dron                `protobuf:"bytes,1,rep,name=drones,proto3" json:"drones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDronesResponse) Reset() {
+	*x = ListDronesResponse{}
+	mi := &file_emergencia_v2_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDronesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDronesResponse) ProtoMessage() {}
+
+func (x *ListDronesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDronesResponse.ProtoReflect.Descriptor instead.
+func (*ListDronesResponse) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDronesResponse) GetDrones() []*Dron {
+	if x != nil {
+		return x.Drones
+	}
+	return nil
+}
+
+type MantenimientoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mantenimiento bool                   `protobuf:"varint,2,opt,name=mantenimiento,proto3" json:"mantenimiento,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MantenimientoRequest) Reset() {
+	*x = MantenimientoRequest{}
+	mi := &file_emergencia_v2_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MantenimientoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MantenimientoRequest) ProtoMessage() {}
+
+func (x *MantenimientoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MantenimientoRequest.ProtoReflect.Descriptor instead.
+func (*MantenimientoRequest) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MantenimientoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MantenimientoRequest) GetMantenimiento() bool {
+	if x != nil {
+		return x.Mantenimiento
+	}
+	return false
+}
+
+// Evento de una misión; mismos campos que en la versión 1, que es el formato en que los
+// servicios lo publican en acciones_dron
+type EventoMonitoreo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Tipo        TipoEvento             `protobuf:"varint,1,opt,name=tipo,proto3,enum=emergencia.v2.TipoEvento" json:"tipo,omitempty"`
+	EmergencyId int32                  `protobuf:"varint,2,opt,name=emergency_id,json=emergencyId,proto3" json:"emergency_id,omitempty"`
+	DronId      string                 `protobuf:"bytes,3,opt,name=dron_id,json=dronId,proto3" json:"dron_id,omitempty"`
+	// Nombre de la emergencia
+	Emergencia string `protobuf:"bytes,4,opt,name=emergencia,proto3" json:"emergencia,omitempty"`
+	// Posición del dron al publicar el evento
+	Latitude  float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Avance entre 0 y 1 de la etapa actual (en camino o apagando)
+	Progreso  float64                `protobuf:"fixed64,7,opt,name=progreso,proto3" json:"progreso,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hitos de la misión alcanzados hasta este evento; los que aún no ocurren van vacíos
+	AssignedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	ArrivedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=arrived_at,json=arrivedAt,proto3" json:"arrived_at,omitempty"`
+	ExtinguishedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=extinguished_at,json=extinguishedAt,proto3" json:"extinguished_at,omitempty"`
+	// Ubicación de la emergencia, que no cambia mientras el dron se desplaza
+	EmergencyLatitude  float64 `protobuf:"fixed64,12,opt,name=emergency_latitude,json=emergencyLatitude,proto3" json:"emergency_latitude,omitempty"`
+	EmergencyLongitude float64 `protobuf:"fixed64,13,opt,name=emergency_longitude,json=emergencyLongitude,proto3" json:"emergency_longitude,omitempty"`
+	// Hora en que se reportó la emergencia
+	ReportedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=reported_at,json=reportedAt,proto3" json:"reported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventoMonitoreo) Reset() {
+	*x = EventoMonitoreo{}
+	mi := &file_emergencia_v2_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventoMonitoreo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventoMonitoreo) ProtoMessage() {}
+
+func (x *EventoMonitoreo) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventoMonitoreo.ProtoReflect.Descriptor instead.
+func (*EventoMonitoreo) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EventoMonitoreo) GetTipo() TipoEvento {
+	if x != nil {
+		return x.Tipo
+	}
+	return TipoEvento_EVENTO_DESCONOCIDO
+}
+
+func (x *EventoMonitoreo) GetEmergencyId() int32 {
+	if x != nil {
+		return x.EmergencyId
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetDronId() string {
+	if x != nil {
+		return x.DronId
+	}
+	return ""
+}
+
+func (x *EventoMonitoreo) GetEmergencia() string {
+	if x != nil {
+		return x.Emergencia
+	}
+	return ""
+}
+
+func (x *EventoMonitoreo) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetProgreso() float64 {
+	if x != nil {
+		return x.Progreso
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetArrivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArrivedAt
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetExtinguishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExtinguishedAt
+	}
+	return nil
+}
+
+func (x *EventoMonitoreo) GetEmergencyLatitude() float64 {
+	if x != nil {
+		return x.EmergencyLatitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetEmergencyLongitude() float64 {
+	if x != nil {
+		return x.EmergencyLongitude
+	}
+	return 0
+}
+
+func (x *EventoMonitoreo) GetReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReportedAt
+	}
+	return nil
+}
+
+type MensajeMonitoreo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Texto legible del evento, para mostrar
+	Contenido string                 `protobuf:"bytes,1,opt,name=contenido,proto3" json:"contenido,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Vacío si el mensaje llegó como texto libre de un servicio sin actualizar
+	Evento *EventoMonitoreo `protobuf:"bytes,3,opt,name=evento,proto3" json:"evento,omitempty"`
+	// Presente solo en los avisos de salto: el suscriptor se atrasó más de lo que retiene el
+	// servidor y los mensajes intermedios se descartaron sin entregárselos
+	Salto *SaltoMonitoreo `protobuf:"bytes,4,opt,name=salto,proto3" json:"salto,omitempty"`
+	// Número de secuencia del mensaje en el servidor de monitoreo, creciente y empezando en 1;
+	// 0 en los avisos de salto. Vuelve a empezar si el servidor se reinicia, junto con la época.
+	Secuencia uint64 `protobuf:"varint,5,opt,name=secuencia,proto3" json:"secuencia,omitempty"`
+	// Identifica la ejecución del servidor que numeró el mensaje; cambia en cada reinicio, de
+	// modo que una secuencia solo tiene sentido junto con su época
+	Epoca string `protobuf:"bytes,6,opt,name=epoca,proto3" json:"epoca,omitempty"`
+	// Instante en que el mensaje llegó al servidor de monitoreo, que es el que compara
+	// desde_instante; timestamp es el instante del evento según quien lo publicó
+	Recibido      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=recibido,proto3" json:"recibido,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MensajeMonitoreo) Reset() {
+	*x = MensajeMonitoreo{}
+	mi := &file_emergencia_v2_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MensajeMonitoreo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MensajeMonitoreo) ProtoMessage() {}
+
+func (x *MensajeMonitoreo) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MensajeMonitoreo.ProtoReflect.Descriptor instead.
+func (*MensajeMonitoreo) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MensajeMonitoreo) GetContenido() string {
+	if x != nil {
+		return x.Contenido
+	}
+	return ""
+}
+
+func (x *MensajeMonitoreo) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *MensajeMonitoreo) GetEvento() *EventoMonitoreo {
+	if x != nil {
+		return x.Evento
+	}
+	return nil
+}
+
+func (x *MensajeMonitoreo) GetSalto() *SaltoMonitoreo {
+	if x != nil {
+		return x.Salto
+	}
+	return nil
+}
+
+func (x *MensajeMonitoreo) GetSecuencia() uint64 {
+	if x != nil {
+		return x.Secuencia
+	}
+	return 0
+}
+
+func (x *MensajeMonitoreo) GetEpoca() string {
+	if x != nil {
+		return x.Epoca
+	}
+	return ""
+}
+
+func (x *MensajeMonitoreo) GetRecibido() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Recibido
+	}
+	return nil
+}
+
+// Mensajes que un suscriptor de StreamMensajes no alcanzó a recibir
+type SaltoMonitoreo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 si se desconoce, cuando reinicio es true
+	Perdidos uint64 `protobuf:"varint,1,opt,name=perdidos,proto3" json:"perdidos,omitempty"`
+	// Secuencia del primer mensaje perdido
+	Desde uint64 `protobuf:"varint,2,opt,name=desde,proto3" json:"desde,omitempty"`
+	// La suscripción pidió una secuencia de otra época: el servidor se reinició y los mensajes
+	// que no alcanzó a entregar antes se perdieron. La entrega sigue desde el mensaje más
+	// antiguo retenido de la época actual.
+	Reinicio      bool `protobuf:"varint,3,opt,name=reinicio,proto3" json:"reinicio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaltoMonitoreo) Reset() {
+	*x = SaltoMonitoreo{}
+	mi := &file_emergencia_v2_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaltoMonitoreo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaltoMonitoreo) ProtoMessage() {}
+
+func (x *SaltoMonitoreo) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaltoMonitoreo.ProtoReflect.Descriptor instead.
+func (*SaltoMonitoreo) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SaltoMonitoreo) GetPerdidos() uint64 {
+	if x != nil {
+		return x.Perdidos
+	}
+	return 0
+}
+
+func (x *SaltoMonitoreo) GetDesde() uint64 {
+	if x != nil {
+		return x.Desde
+	}
+	return 0
+}
+
+func (x *SaltoMonitoreo) GetReinicio() bool {
+	if x != nil {
+		return x.Reinicio
+	}
+	return false
+}
+
+// Opciones de una suscripción a StreamMensajes. Una suscripción vacía recibe en tiempo real
+// todos los mensajes que lleguen desde ahora, igual que StreamMensajes de la versión 1.
+type SuscripcionMonitoreo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Modo  ModoEntrega            `protobuf:"varint,1,opt,name=modo,proto3,enum=emergencia.v2.ModoEntrega" json:"modo,omitempty"`
+	// Para ENTREGA_LIMITADA; 1 si no se indica
+	MensajesPorSegundo float64 `protobuf:"fixed64,2,opt,name=mensajes_por_segundo,json=mensajesPorSegundo,proto3" json:"mensajes_por_segundo,omitempty"`
+	// Para ENTREGA_POR_LOTES; 5 segundos si no se indica
+	IntervaloLote *durationpb.Duration `protobuf:"bytes,3,opt,name=intervalo_lote,json=intervaloLote,proto3" json:"intervalo_lote,omitempty"`
+	// Para ENTREGA_POR_LOTES, máximo de mensajes por lote; los demás pasan al siguiente
+	TamanoLote int32 `protobuf:"varint,4,opt,name=tamano_lote,json=tamanoLote,proto3" json:"tamano_lote,omitempty"`
+	// Espera máxima por cada envío antes de cerrar el stream con RESOURCE_EXHAUSTED. Sin
+	// indicar se usa el plazo del servidor, que también es el máximo aceptado.
+	PlazoEnvio *durationpb.Duration `protobuf:"bytes,11,opt,name=plazo_envio,json=plazoEnvio,proto3" json:"plazo_envio,omitempty"`
+	// Filtros aplicados por el servidor; los vacíos no filtran y los indicados se combinan.
+	// Con algún filtro indicado solo se entregan eventos estructurados, nunca mensajes de
+	// texto libre; los avisos de salto se entregan siempre.
+	EmergencyIds []int32      `protobuf:"varint,5,rep,packed,name=emergency_ids,json=emergencyIds,proto3" json:"emergency_ids,omitempty"`
+	DronIds      []string     `protobuf:"bytes,6,rep,name=dron_ids,json=dronIds,proto3" json:"dron_ids,omitempty"`
+	Tipos        []TipoEvento `protobuf:"varint,7,rep,packed,name=tipos,proto3,enum=emergencia.v2.TipoEvento" json:"tipos,omitempty"`
+	// Solo eventos de emergencias ubicadas dentro de la región, esté donde esté el dron
+	Region *RegionMonitoreo `protobuf:"bytes,8,opt,name=region,proto3" json:"region,omitempty"`
+	// Dónde empezar; sin indicar, solo se reciben los mensajes que lleguen desde ahora. Si el
+	// punto indicado ya no está retenido se empieza por el mensaje más antiguo retenido,
+	// precedido de un aviso de salto.
+	//
+	// Types that are valid to be assigned to Inicio:
+	//
+	//	*SuscripcionMonitoreo_DesdeSecuencia
+	//	*SuscripcionMonitoreo_DesdeInstante
+	Inicio isSuscripcionMonitoreo_Inicio `protobuf_oneof:"inicio"`
+	// Época de desde_secuencia. Si no es la del servidor, se empieza por el mensaje más antiguo
+	// retenido, precedido de un aviso de salto con reinicio.
+	Epoca         string `protobuf:"bytes,12,opt,name=epoca,proto3" json:"epoca,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuscripcionMonitoreo) Reset() {
+	*x = SuscripcionMonitoreo{}
+	mi := &file_emergencia_v2_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuscripcionMonitoreo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuscripcionMonitoreo) ProtoMessage() {}
+
+func (x *SuscripcionMonitoreo) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuscripcionMonitoreo.ProtoReflect.Descriptor instead.
+func (*SuscripcionMonitoreo) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SuscripcionMonitoreo) GetModo() ModoEntrega {
+	if x != nil {
+		return x.Modo
+	}
+	return ModoEntrega_ENTREGA_TIEMPO_REAL
+}
+
+func (x *SuscripcionMonitoreo) GetMensajesPorSegundo() float64 {
+	if x != nil {
+		return x.MensajesPorSegundo
+	}
+	return 0
+}
+
+func (x *SuscripcionMonitoreo) GetIntervaloLote() *durationpb.Duration {
+	if x != nil {
+		return x.IntervaloLote
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetTamanoLote() int32 {
+	if x != nil {
+		return x.TamanoLote
+	}
+	return 0
+}
+
+func (x *SuscripcionMonitoreo) GetPlazoEnvio() *durationpb.Duration {
+	if x != nil {
+		return x.PlazoEnvio
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetEmergencyIds() []int32 {
+	if x != nil {
+		return x.EmergencyIds
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetDronIds() []string {
+	if x != nil {
+		return x.DronIds
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetTipos() []TipoEvento {
+	if x != nil {
+		return x.Tipos
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetRegion() *RegionMonitoreo {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetInicio() isSuscripcionMonitoreo_Inicio {
+	if x != nil {
+		return x.Inicio
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetDesdeSecuencia() uint64 {
+	if x != nil {
+		if x, ok := x.Inicio.(*SuscripcionMonitoreo_DesdeSecuencia); ok {
+			return x.DesdeSecuencia
+		}
+	}
+	return 0
+}
+
+func (x *SuscripcionMonitoreo) GetDesdeInstante() *timestamppb.Timestamp {
+	if x != nil {
+		if x, ok := x.Inicio.(*SuscripcionMonitoreo_DesdeInstante); ok {
+			return x.DesdeInstante
+		}
+	}
+	return nil
+}
+
+func (x *SuscripcionMonitoreo) GetEpoca() string {
+	if x != nil {
+		return x.Epoca
+	}
+	return ""
+}
+
+type isSuscripcionMonitoreo_Inicio interface {
+	isSuscripcionMonitoreo_Inicio()
+}
+
+type SuscripcionMonitoreo_DesdeSecuencia struct {
+	// Para retomar un stream cortado, la secuencia del último mensaje recibido más 1, junto
+	// con su época. Sin época, una secuencia que el servidor aún no asigna da OUT_OF_RANGE.
+	DesdeSecuencia uint64 `protobuf:"varint,9,opt,name=desde_secuencia,json=desdeSecuencia,proto3,oneof"`
+}
+
+type SuscripcionMonitoreo_DesdeInstante struct {
+	// Primer mensaje cuyo recibido es este instante o posterior
+	DesdeInstante *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=desde_instante,json=desdeInstante,proto3,oneof"`
+}
+
+func (*SuscripcionMonitoreo_DesdeSecuencia) isSuscripcionMonitoreo_Inicio() {}
+
+func (*SuscripcionMonitoreo_DesdeInstante) isSuscripcionMonitoreo_Inicio() {}
+
+// Rectángulo de coordenadas, con los límites incluidos
+type RegionMonitoreo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LatitudMin    float64                `protobuf:"fixed64,1,opt,name=latitud_min,json=latitudMin,proto3" json:"latitud_min,omitempty"`
+	LatitudMax    float64                `protobuf:"fixed64,2,opt,name=latitud_max,json=latitudMax,proto3" json:"latitud_max,omitempty"`
+	LongitudMin   float64                `protobuf:"fixed64,3,opt,name=longitud_min,json=longitudMin,proto3" json:"longitud_min,omitempty"`
+	LongitudMax   float64                `protobuf:"fixed64,4,opt,name=longitud_max,json=longitudMax,proto3" json:"longitud_max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegionMonitoreo) Reset() {
+	*x = RegionMonitoreo{}
+	mi := &file_emergencia_v2_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionMonitoreo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionMonitoreo) ProtoMessage() {}
+
+func (x *RegionMonitoreo) ProtoReflect() protoreflect.Message {
+	mi := &file_emergencia_v2_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegionMonitoreo.ProtoReflect.Descriptor instead.
+func (*RegionMonitoreo) Descriptor() ([]byte, []int) {
+	return file_emergencia_v2_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RegionMonitoreo) GetLatitudMin() float64 {
+	if x != nil {
+		return x.LatitudMin
+	}
+	return 0
+}
+
+func (x *RegionMonitoreo) GetLatitudMax() float64 {
+	if x != nil {
+		return x.LatitudMax
+	}
+	return 0
+}
+
+func (x *RegionMonitoreo) GetLongitudMin() float64 {
+	if x != nil {
+		return x.LongitudMin
+	}
+	return 0
+}
+
+func (x *RegionMonitoreo) GetLongitudMax() float64 {
+	if x != nil {
+		return x.LongitudMax
+	}
+	return 0
+}
+
+var File_emergencia_v2_proto protoreflect.FileDescriptor
+
+const file_emergencia_v2_proto_rawDesc = "" +
+	"\n" +
+	"\x13emergencia_v2.proto\x12\remergencia.v2\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x01\n" +
+	"\n" +
+	"Emergencia\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x1c\n" +
+	"\tmagnitude\x18\x04 \x01(\x05R\tmagnitude\x12-\n" +
+	"\x12clave_idempotencia\x18\x05 \x01(\tR\x11claveIdempotencia\"W\n" +
+	"\x18EnviarEmergenciasRequest\x12;\n" +
+	"\vemergencias\x18\x01 \x03(\v2\x19.emergencia.v2.EmergenciaR\vemergencias\"Z\n" +
+	"\x19EnviarEmergenciasResponse\x12=\n" +
+	"\tencoladas\x18\x01 \x03(\v2\x1f.emergencia.v2.EmergenciaEnColaR\tencoladas\"\xa3\x02\n" +
+	"\x10EmergenciaEnCola\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tmagnitude\x18\x03 \x01(\x05R\tmagnitude\x12\x1a\n" +
+	"\bposicion\x18\x04 \x01(\x05R\bposicion\x121\n" +
+	"\x06espera\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x06espera\x12\x1c\n" +
+	"\tprioridad\x18\x06 \x01(\x01R\tprioridad\x12\x16\n" +
+	"\x06motivo\x18\a \x01(\tR\x06motivo\x12\x17\n" +
+	"\adron_id\x18\b \x01(\tR\x06dronId\x12\x1c\n" +
+	"\tduplicada\x18\t \x01(\bR\tduplicada\"\x16\n" +
+	"\x14ConsultarColaRequest\"Z\n" +
+	"\x15ConsultarColaResponse\x12A\n" +
+	"\vemergencias\x18\x01 \x03(\v2\x1f.emergencia.v2.EmergenciaEnColaR\vemergencias\"\xc9\x01\n" +
+	"\x0fAcuseEmergencia\x12\x16\n" +
+	"\x06indice\x18\x01 \x01(\x05R\x06indice\x12!\n" +
+	"\femergency_id\x18\x02 \x01(\x05R\vemergencyId\x12,\n" +
+	"\x04tipo\x18\x03 \x01(\x0e2\x18.emergencia.v2.TipoAcuseR\x04tipo\x12\x1a\n" +
+	"\bposicion\x18\x04 \x01(\x05R\bposicion\x12\x17\n" +
+	"\adron_id\x18\x05 \x01(\tR\x06dronId\x12\x18\n" +
+	"\adetalle\x18\x06 \x01(\tR\adetalle\"\xee\x01\n" +
+	"\x1bActualizarEmergenciaRequest\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12!\n" +
+	"\tmagnitude\x18\x02 \x01(\x05H\x00R\tmagnitude\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\x03 \x01(\x01H\x01R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x04 \x01(\x01H\x02R\tlongitude\x88\x01\x01\x12\x1c\n" +
+	"\trefuerzos\x18\x05 \x01(\x05R\trefuerzosB\f\n" +
+	"\n" +
+	"_magnitudeB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitude\"\xee\x01\n" +
+	"\x1cActualizarEmergenciaResponse\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12+\n" +
+	"\x11magnitud_anterior\x18\x02 \x01(\x05R\x10magnitudAnterior\x12\x1c\n" +
+	"\tmagnitude\x18\x03 \x01(\x05R\tmagnitude\x12/\n" +
+	"\x13drones_actualizados\x18\x04 \x03(\tR\x12dronesActualizados\x12/\n" +
+	"\x13refuerzos_encolados\x18\x05 \x01(\x05R\x12refuerzosEncolados\"V\n" +
+	"\x19CancelarEmergenciaRequest\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x16\n" +
+	"\x06motivo\x18\x02 \x01(\tR\x06motivo\"\xa2\x01\n" +
+	"\x1aCancelarEmergenciaResponse\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12H\n" +
+	"\x0festado_anterior\x18\x02 \x01(\x0e2\x1f.emergencia.v2.EstadoEmergenciaR\x0eestadoAnterior\x12\x17\n" +
+	"\adron_id\x18\x03 \x01(\tR\x06dronId\"\xda\x01\n" +
+	"\x11IntentoAsignacion\x12\x17\n" +
+	"\adron_id\x18\x01 \x01(\tR\x06dronId\x12\x16\n" +
+	"\x06numero\x18\x02 \x01(\x05R\x06numero\x122\n" +
+	"\x06inicio\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06inicio\x12,\n" +
+	"\x03fin\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03fin\x12\x1c\n" +
+	"\tresultado\x18\x05 \x01(\tR\tresultado\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xcc\x05\n" +
+	"\x14EmergenciaRegistrada\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x1c\n" +
+	"\tmagnitude\x18\x05 \x01(\x05R\tmagnitude\x127\n" +
+	"\x06estado\x18\x06 \x01(\x0e2\x1f.emergencia.v2.EstadoEmergenciaR\x06estado\x12\x17\n" +
+	"\adron_id\x18\a \x01(\tR\x06dronId\x12-\n" +
+	"\x12clave_idempotencia\x18\b \x01(\tR\x11claveIdempotencia\x12-\n" +
+	"\x12motivo_cancelacion\x18\t \x01(\tR\x11motivoCancelacion\x12<\n" +
+	"\bintentos\x18\n" +
+	" \x03(\v2 .emergencia.v2.IntentoAsignacionR\bintentos\x12;\n" +
+	"\vreported_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reportedAt\x12;\n" +
+	"\vassigned_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x129\n" +
+	"\n" +
+	"arrived_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tarrivedAt\x12C\n" +
+	"\x0fextinguished_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0eextinguishedAt\x12=\n" +
+	"\fcancelled_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"9\n" +
+	"\x14GetEmergenciaRequest\x12!\n" +
+	"\femergency_id\x18\x01 \x01(\x05R\vemergencyId\"\xde\x02\n" +
+	"\x16ListEmergenciasRequest\x129\n" +
+	"\aestados\x18\x01 \x03(\x0e2\x1f.emergencia.v2.EstadoEmergenciaR\aestados\x12!\n" +
+	"\fmagnitud_min\x18\x02 \x01(\x05R\vmagnitudMin\x12!\n" +
+	"\fmagnitud_max\x18\x03 \x01(\x05R\vmagnitudMax\x12\x17\n" +
+	"\adron_id\x18\x04 \x01(\tR\x06dronId\x120\n" +
+	"\x05desde\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05desde\x120\n" +
+	"\x05hasta\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05hasta\x12#\n" +
+	"\rtamano_pagina\x18\a \x01(\x05R\ftamanoPagina\x12!\n" +
+	"\ftoken_pagina\x18\b \x01(\tR\vtokenPagina\"\x9f\x01\n" +
+	"\x17ListEmergenciasResponse\x12E\n" +
+	"\vemergencias\x18\x01 \x03(\v2#.emergencia.v2.EmergenciaRegistradaR\vemergencias\x12'\n" +
+	"\x0fsiguiente_token\x18\x02 \x01(\tR\x0esiguienteToken\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"\xc3\x01\n" +
+	"\x04Dron\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x121\n" +
+	"\x06estado\x18\x04 \x01(\x0e2\x19.emergencia.v2.EstadoDronR\x06estado\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12$\n" +
+	"\rmantenimiento\x18\x06 \x01(\bR\rmantenimiento\"z\n" +
+	"\x14RegistrarDronRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\"\x1d\n" +
+	"\vDronRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x11ListDronesRequest\x121\n" +
+	"\x06estado\x18\x01 \x01(\x0e2\x19.emergencia.v2.EstadoDronR\x06estado\"A\n" +
+	"\x12ListDronesResponse\x12+\n" +
+	"\x06drones\x18\x01 \x03(\v2\x13.emergencia.v2.DronR\x06drones\"L\n" +
+	"\x14MantenimientoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\rmantenimiento\x18\x02 \x01(\bR\rmantenimiento\"\x86\x05\n" +
+	"\x0fEventoMonitoreo\x12-\n" +
+	"\x04tipo\x18\x01 \x01(\x0e2\x19.emergencia.v2.TipoEventoR\x04tipo\x12!\n" +
+	"\femergency_id\x18\x02 \x01(\x05R\vemergencyId\x12\x17\n" +
+	"\adron_id\x18\x03 \x01(\tR\x06dronId\x12\x1e\n" +
+	"\n" +
+	"emergencia\x18\x04 \x01(\tR\n" +
+	"emergencia\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\bprogreso\x18\a \x01(\x01R\bprogreso\x128\n" +
+	"\ttimestamp\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12;\n" +
+	"\vassigned_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x129\n" +
+	"\n" +
+	"arrived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tarrivedAt\x12C\n" +
+	"\x0fextinguished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eextinguishedAt\x12-\n" +
+	"\x12emergency_latitude\x18\f \x01(\x01R\x11emergencyLatitude\x12/\n" +
+	"\x13emergency_longitude\x18\r \x01(\x01R\x12emergencyLongitude\x12;\n" +
+	"\vreported_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reportedAt\"\xc3\x02\n" +
+	"\x10MensajeMonitoreo\x12\x1c\n" +
+	"\tcontenido\x18\x01 \x01(\tR\tcontenido\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x126\n" +
+	"\x06evento\x18\x03 \x01(\v2\x1e.emergencia.v2.EventoMonitoreoR\x06evento\x123\n" +
+	"\x05salto\x18\x04 \x01(\v2\x1d.emergencia.v2.SaltoMonitoreoR\x05salto\x12\x1c\n" +
+	"\tsecuencia\x18\x05 \x01(\x04R\tsecuencia\x12\x14\n" +
+	"\x05epoca\x18\x06 \x01(\tR\x05epoca\x126\n" +
+	"\brecibido\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\brecibido\"^\n" +
+	"\x0eSaltoMonitoreo\x12\x1a\n" +
+	"\bperdidos\x18\x01 \x01(\x04R\bperdidos\x12\x14\n" +
+	"\x05desde\x18\x02 \x01(\x04R\x05desde\x12\x1a\n" +
+	"\breinicio\x18\x03 \x01(\bR\breinicio\"\xd0\x04\n" +
+	"\x14SuscripcionMonitoreo\x12.\n" +
+	"\x04modo\x18\x01 \x01(\x0e2\x1a.emergencia.v2.ModoEntregaR\x04modo\x120\n" +
+	"\x14mensajes_por_segundo\x18\x02 \x01(\x01R\x12mensajesPorSegundo\x12@\n" +
+	"\x0eintervalo_lote\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rintervaloLote\x12\x1f\n" +
+	"\vtamano_lote\x18\x04 \x01(\x05R\n" +
+	"tamanoLote\x12:\n" +
+	"\vplazo_envio\x18\v \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"plazoEnvio\x12#\n" +
+	"\remergency_ids\x18\x05 \x03(\x05R\femergencyIds\x12\x19\n" +
+	"\bdron_ids\x18\x06 \x03(\tR\adronIds\x12/\n" +
+	"\x05tipos\x18\a \x03(\x0e2\x19.emergencia.v2.TipoEventoR\x05tipos\x126\n" +
+	"\x06region\x18\b \x01(\v2\x1e.emergencia.v2.RegionMonitoreoR\x06region\x12)\n" +
+	"\x0fdesde_secuencia\x18\t \x01(\x04H\x00R\x0edesdeSecuencia\x12C\n" +
+	"\x0edesde_instante\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rdesdeInstante\x12\x14\n" +
+	"\x05epoca\x18\f \x01(\tR\x05epocaB\b\n" +
+	"\x06inicio\"\x99\x01\n" +
+	"\x0fRegionMonitoreo\x12\x1f\n" +
+	"\vlatitud_min\x18\x01 \x01(\x01R\n" +
+	"latitudMin\x12\x1f\n" +
+	"\vlatitud_max\x18\x02 \x01(\x01R\n" +
+	"latitudMax\x12!\n" +
+	"\flongitud_min\x18\x03 \x01(\x01R\vlongitudMin\x12!\n" +
+	"\flongitud_max\x18\x04 \x01(\x01R\vlongitudMax*\x96\x01\n" +
+	"\x10EstadoEmergencia\x12\x1a\n" +
+	"\x16EMERGENCIA_DESCONOCIDA\x10\x00\x12\x18\n" +
+	"\x14EMERGENCIA_PENDIENTE\x10\x01\x12\x17\n" +
+	"\x13EMERGENCIA_EN_CURSO\x10\x02\x12\x19\n" +
+	"\x15EMERGENCIA_EXTINGUIDA\x10\x03\x12\x18\n" +
+	"\x14EMERGENCIA_CANCELADA\x10\x04*q\n" +
+	"\n" +
+	"EstadoDron\x12\x14\n" +
+	"\x10DRON_DESCONOCIDO\x10\x00\x12\x13\n" +
+	"\x0fDRON_DISPONIBLE\x10\x01\x12\x11\n" +
+	"\rDRON_ASIGNADO\x10\x02\x12\x12\n" +
+	"\x0eDRON_EN_MISION\x10\x03\x12\x11\n" +
+	"\rDRON_AVERIADO\x10\x04*t\n" +
+	"\tTipoAcuse\x12\x15\n" +
+	"\x11ACUSE_DESCONOCIDO\x10\x00\x12\x12\n" +
+	"\x0eACUSE_ENCOLADA\x10\x01\x12\x12\n" +
+	"\x0eACUSE_ASIGNADA\x10\x02\x12\x13\n" +
+	"\x0fACUSE_CANCELADA\x10\x03\x12\x13\n" +
+	"\x0fACUSE_DUPLICADA\x10\x04*\xdd\x01\n" +
+	"\n" +
+	"TipoEvento\x12\x16\n" +
+	"\x12EVENTO_DESCONOCIDO\x10\x00\x12\x13\n" +
+	"\x0fEVENTO_ASIGNADO\x10\x01\x12\x14\n" +
+	"\x10EVENTO_EN_CAMINO\x10\x02\x12\x13\n" +
+	"\x0fEVENTO_APAGANDO\x10\x03\x12\x15\n" +
+	"\x11EVENTO_EXTINGUIDA\x10\x04\x12\x14\n" +
+	"\x10EVENTO_CANCELADA\x10\x05\x12\x13\n" +
+	"\x0fEVENTO_ABORTADA\x10\x06\x12\x16\n" +
+	"\x12EVENTO_ACTUALIZADA\x10\a\x12\x1d\n" +
+	"\x19EVENTO_REFUERZO_TERMINADO\x10\b*S\n" +
+	"\vModoEntrega\x12\x17\n" +
+	"\x13ENTREGA_TIEMPO_REAL\x10\x00\x12\x14\n" +
+	"\x10ENTREGA_LIMITADA\x10\x01\x12\x15\n" +
+	"\x11ENTREGA_POR_LOTES\x10\x022\xc2\x05\n" +
+	"\tAsignador\x12f\n" +
+	"\x11EnviarEmergencias\x12'.emergencia.v2.EnviarEmergenciasRequest\x1a(.emergencia.v2.EnviarEmergenciasResponse\x12X\n" +
+	"\x17EnviarEmergenciasStream\x12\x19.emergencia.v2.Emergencia\x1a\x1e.emergencia.v2.AcuseEmergencia(\x010\x01\x12Z\n" +
+	"\rConsultarCola\x12#.emergencia.v2.ConsultarColaRequest\x1a$.emergencia.v2.ConsultarColaResponse\x12i\n" +
+	"\x12CancelarEmergencia\x12(.emergencia.v2.CancelarEmergenciaRequest\x1a).emergencia.v2.CancelarEmergenciaResponse\x12o\n" +
+	"\x14ActualizarEmergencia\x12*.emergencia.v2.ActualizarEmergenciaRequest\x1a+.emergencia.v2.ActualizarEmergenciaResponse\x12Y\n" +
+	"\rGetEmergencia\x12#.emergencia.v2.GetEmergenciaRequest\x1a#.emergencia.v2.EmergenciaRegistrada\x12`\n" +
+	"\x0fListEmergencias\x12%.emergencia.v2.ListEmergenciasRequest\x1a&.emergencia.v2.ListEmergenciasResponse2\xf6\x02\n" +
+	"\x05Flota\x12H\n" +
+	"\fRegisterDron\x12#.emergencia.v2.RegistrarDronRequest\x1a\x13.emergencia.v2.Dron\x12D\n" +
+	"\x0eDeregisterDron\x12\x1a.emergencia.v2.DronRequest\x1a\x16.google.protobuf.Empty\x12Q\n" +
+	"\n" +
+	"ListDrones\x12 .emergencia.v2.ListDronesRequest\x1a!.emergencia.v2.ListDronesResponse\x12:\n" +
+	"\aGetDron\x12\x1a.emergencia.v2.DronRequest\x1a\x13.emergencia.v2.Dron\x12N\n" +
+	"\x12SetDronMaintenance\x12#.emergencia.v2.MantenimientoRequest\x1a\x13.emergencia.v2.Dron2e\n" +
+	"\tMonitoreo\x12X\n" +
+	"\x0eStreamMensajes\x12#.emergencia.v2.SuscripcionMonitoreo\x1a\x1f.emergencia.v2.MensajeMonitoreo0\x01B\x1eZ\x1c./emergencia/v2;emergenciav2b\x06proto3"
+
+var (
+	file_emergencia_v2_proto_rawDescOnce sync.Once
+	file_emergencia_v2_proto_rawDescData []byte
+)
+
+func file_emergencia_v2_proto_rawDescGZIP() []byte {
+	file_emergencia_v2_proto_rawDescOnce.Do(func() {
+		file_emergencia_v2_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_emergencia_v2_proto_rawDesc), len(file_emergencia_v2_proto_rawDesc)))
+	})
+	return file_emergencia_v2_proto_rawDescData
+}
+
+var file_emergencia_v2_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_emergencia_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_emergencia_v2_proto_goTypes = []any{
+	(EstadoEmergencia)(0),                // 0: emergencia.v2.EstadoEmergencia
+	(EstadoDron)(0),                      // 1: emergencia.v2.EstadoDron
+	(TipoAcuse)(0),                       // 2: emergencia.v2.TipoAcuse
+	(TipoEvento)(0),                      // 3: emergencia.v2.TipoEvento
+	(ModoEntrega)(0),                     // 4: emergencia.v2.ModoEntrega
+	(*Emergencia)(nil),                   // 5: emergencia.v2.Emergencia
+	(*EnviarEmergenciasRequest)(nil),     // 6: emergencia.v2.EnviarEmergenciasRequest
+	(*EnviarEmergenciasResponse)(nil),    // 7: emergencia.v2.EnviarEmergenciasResponse
+	(*EmergenciaEnCola)(nil),             // 8: emergencia.v2.EmergenciaEnCola
+	(*ConsultarColaRequest)(nil),         // 9: emergencia.v2.ConsultarColaRequest
+	(*ConsultarColaResponse)(nil),        // 10: emergencia.v2.ConsultarColaResponse
+	(*AcuseEmergencia)(nil),              // 11: emergencia.v2.AcuseEmergencia
+	(*ActualizarEmergenciaRequest)(nil),  // 12: emergencia.v2.ActualizarEmergenciaRequest
+	(*ActualizarEmergenciaResponse)(nil), // 13: emergencia.v2.ActualizarEmergenciaResponse
+	(*CancelarEmergenciaRequest)(nil),    // 14: emergencia.v2.CancelarEmergenciaRequest
+	(*CancelarEmergenciaResponse)(nil),   // 15: emergencia.v2.CancelarEmergenciaResponse
+	(*IntentoAsignacion)(nil),            // 16: emergencia.v2.IntentoAsignacion
+	(*EmergenciaRegistrada)(nil),         // 17: emergencia.v2.EmergenciaRegistrada
+	(*GetEmergenciaRequest)(nil),         // 18: emergencia.v2.GetEmergenciaRequest
+	(*ListEmergenciasRequest)(nil),       // 19: emergencia.v2.ListEmergenciasRequest
+	(*ListEmergenciasResponse)(nil),      // 20: emergencia.v2.ListEmergenciasResponse
+	(*Dron)(nil),                         // 21: emergencia.v2.Dron
+	(*RegistrarDronRequest)(nil),         // 22: emergencia.v2.RegistrarDronRequest
+	(*DronRequest)(nil),                  // 23: emergencia.v2.DronRequest
+	(*ListDronesRequest)(nil),            // 24: emergencia.v2.ListDronesRequest
+	(*ListDronesResponse)(nil),           // 25: emergencia.v2.ListDronesResponse
+	(*MantenimientoRequest)(nil),         // 26: emergencia.v2.MantenimientoRequest
+	(*EventoMonitoreo)(nil),              // 27: emergencia.v2.EventoMonitoreo
+	(*MensajeMonitoreo)(nil),             // 28: emergencia.v2.MensajeMonitoreo
+	(*SaltoMonitoreo)(nil),               // 29: emergencia.v2.SaltoMonitoreo
+	(*SuscripcionMonitoreo)(nil),         // 30: emergencia.v2.SuscripcionMonitoreo
+	(*RegionMonitoreo)(nil),              // 31: emergencia.v2.RegionMonitoreo
+	(*durationpb.Duration)(nil),          // 32: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 34: google.protobuf.Empty
+}
+var file_emergencia_v2_proto_depIdxs = []int32{
+	5,  // 0: emergencia.v2.EnviarEmergenciasRequest.emergencias:type_name -> emergencia.v2.Emergencia
+	8,  // 1: emergencia.v2.EnviarEmergenciasResponse.encoladas:type_name -> emergencia.v2.EmergenciaEnCola
+	32, // 2: emergencia.v2.EmergenciaEnCola.espera:type_name -> google.protobuf.Duration
+	8,  // 3: emergencia.v2.ConsultarColaResponse.emergencias:type_name -> emergencia.v2.EmergenciaEnCola
+	2,  // 4: emergencia.v2.AcuseEmergencia.tipo:type_name -> emergencia.v2.TipoAcuse
+	0,  // 5: emergencia.v2.CancelarEmergenciaResponse.estado_anterior:type_name -> emergencia.v2.EstadoEmergencia
+	33, // 6: emergencia.v2.IntentoAsignacion.inicio:type_name -> google.protobuf.Timestamp
+	33, // 7: emergencia.v2.IntentoAsignacion.fin:type_name -> google.protobuf.Timestamp
+	0,  // 8: emergencia.v2.EmergenciaRegistrada.estado:type_name -> emergencia.v2.EstadoEmergencia
+	16, // 9: emergencia.v2.EmergenciaRegistrada.intentos:type_name -> emergencia.v2.IntentoAsignacion
+	33, // 10: emergencia.v2.EmergenciaRegistrada.reported_at:type_name -> google.protobuf.Timestamp
+	33, // 11: emergencia.v2.EmergenciaRegistrada.assigned_at:type_name -> google.protobuf.Timestamp
+	33, // 12: emergencia.v2.EmergenciaRegistrada.arrived_at:type_name -> google.protobuf.Timestamp
+	33, // 13: emergencia.v2.EmergenciaRegistrada.extinguished_at:type_name -> google.protobuf.Timestamp
+	33, // 14: emergencia.v2.EmergenciaRegistrada.cancelled_at:type_name -> google.protobuf.Timestamp
+	0,  // 15: emergencia.v2.ListEmergenciasRequest.estados:type_name -> emergencia.v2.EstadoEmergencia
+	33, // 16: emergencia.v2.ListEmergenciasRequest.desde:type_name -> google.protobuf.Timestamp
+	33, // 17: emergencia.v2.ListEmergenciasRequest.hasta:type_name -> google.protobuf.Timestamp
+	17, // 18: emergencia.v2.ListEmergenciasResponse.emergencias:type_name -> emergencia.v2.EmergenciaRegistrada
+	1,  // 19: emergencia.v2.Dron.estado:type_name -> emergencia.v2.EstadoDron
+	1,  // 20: emergencia.v2.ListDronesRequest.estado:type_name -> emergencia.v2.EstadoDron
+	21, // 21: emergencia.v2.ListDronesResponse.drones:type_name -> emergencia.v2.Dron
+	3,  // 22: emergencia.v2.EventoMonitoreo.tipo:type_name -> emergencia.v2.TipoEvento
+	33, // 23: emergencia.v2.EventoMonitoreo.timestamp:type_name -> google.protobuf.Timestamp
+	33, // 24: emergencia.v2.EventoMonitoreo.assigned_at:type_name -> google.protobuf.Timestamp
+	33, // 25: emergencia.v2.EventoMonitoreo.arrived_at:type_name -> google.protobuf.Timestamp
+	33, // 26: emergencia.v2.EventoMonitoreo.extinguished_at:type_name -> google.protobuf.Timestamp
+	33, // 27: emergencia.v2.EventoMonitoreo.reported_at:type_name -> google.protobuf.Timestamp
+	33, // 28: emergencia.v2.MensajeMonitoreo.timestamp:type_name -> google.protobuf.Timestamp
+	27, // 29: emergencia.v2.MensajeMonitoreo.evento:type_name -> emergencia.v2.EventoMonitoreo
+	29, // 30: emergencia.v2.MensajeMonitoreo.salto:type_name -> emergencia.v2.SaltoMonitoreo
+	33, // 31: emergencia.v2.MensajeMonitoreo.recibido:type_name -> google.protobuf.Timestamp
+	4,  // 32: emergencia.v2.SuscripcionMonitoreo.modo:type_name -> emergencia.v2.ModoEntrega
+	32, // 33: emergencia.v2.SuscripcionMonitoreo.intervalo_lote:type_name -> google.protobuf.Duration
+	32, // 34: emergencia.v2.SuscripcionMonitoreo.plazo_envio:type_name -> google.protobuf.Duration
+	3,  // 35: emergencia.v2.SuscripcionMonitoreo.tipos:type_name -> emergencia.v2.TipoEvento
+	31, // 36: emergencia.v2.SuscripcionMonitoreo.region:type_name -> emergencia.v2.RegionMonitoreo
+	33, // 37: emergencia.v2.SuscripcionMonitoreo.desde_instante:type_name -> google.protobuf.Timestamp
+	6,  // 38: emergencia.v2.Asignador.EnviarEmergencias:input_type -> emergencia.v2.EnviarEmergenciasRequest
+	5,  // 39: emergencia.v2.Asignador.EnviarEmergenciasStream:input_type -> emergencia.v2.Emergencia
+	9,  // 40: emergencia.v2.Asignador.ConsultarCola:input_type -> emergencia.v2.ConsultarColaRequest
+	14, // 41: emergencia.v2.Asignador.CancelarEmergencia:input_type -> emergencia.v2.CancelarEmergenciaRequest
+	12, // 42: emergencia.v2.Asignador.ActualizarEmergencia:input_type -> emergencia.v2.ActualizarEmergenciaRequest
+	18, // 43: emergencia.v2.Asignador.GetEmergencia:input_type -> emergencia.v2.GetEmergenciaRequest
+	19, // 44: emergencia.v2.Asignador.ListEmergencias:input_type -> emergencia.v2.ListEmergenciasRequest
+	22, // 45: emergencia.v2.Flota.RegisterDron:input_type -> emergencia.v2.RegistrarDronRequest
+	23, // 46: emergencia.v2.Flota.DeregisterDron:input_type -> emergencia.v2.DronRequest
+	24, // 47: emergencia.v2.Flota.ListDrones:input_type -> emergencia.v2.ListDronesRequest
+	23, // 48: emergencia.v2.Flota.GetDron:input_type -> emergencia.v2.DronRequest
+	26, // 49: emergencia.v2.Flota.SetDronMaintenance:input_type -> emergencia.v2.MantenimientoRequest
+	30, // 50: emergencia.v2.Monitoreo.StreamMensajes:input_type -> emergencia.v2.SuscripcionMonitoreo
+	7,  // 51: emergencia.v2.Asignador.EnviarEmergencias:output_type -> emergencia.v2.EnviarEmergenciasResponse
+	11, // 52: emergencia.v2.Asignador.EnviarEmergenciasStream:output_type -> emergencia.v2.AcuseEmergencia
+	10, // 53: emergencia.v2.Asignador.ConsultarCola:output_type -> emergencia.v2.ConsultarColaResponse
+	15, // 54: emergencia.v2.Asignador.CancelarEmergencia:output_type -> emergencia.v2.CancelarEmergenciaResponse
+	13, // 55: emergencia.v2.Asignador.ActualizarEmergencia:output_type -> emergencia.v2.ActualizarEmergenciaResponse
+	17, // 56: emergencia.v2.Asignador.GetEmergencia:output_type -> emergencia.v2.EmergenciaRegistrada
+	20, // 57: emergencia.v2.Asignador.ListEmergencias:output_type -> emergencia.v2.ListEmergenciasResponse
+	21, // 58: emergencia.v2.Flota.RegisterDron:output_type -> emergencia.v2.Dron
+	34, // 59: emergencia.v2.Flota.DeregisterDron:output_type -> google.protobuf.Empty
+	25, // 60: emergencia.v2.Flota.ListDrones:output_type -> emergencia.v2.ListDronesResponse
+	21, // 61: emergencia.v2.Flota.GetDron:output_type -> emergencia.v2.Dron
+	21, // 62: emergencia.v2.Flota.SetDronMaintenance:output_type -> emergencia.v2.Dron
+	28, // 63: emergencia.v2.Monitoreo.StreamMensajes:output_type -> emergencia.v2.MensajeMonitoreo
+	51, // [51:64] is the sub-list for method output_type
+	38, // [38:51] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_emergencia_v2_proto_init() }
+func file_emergencia_v2_proto_init() {
+	if File_emergencia_v2_proto != nil {
+		return
+	}
+	file_emergencia_v2_proto_msgTypes[7].OneofWrappers = []any{}
+	file_emergencia_v2_proto_msgTypes[25].OneofWrappers = []any{
+		(*SuscripcionMonitoreo_DesdeSecuencia)(nil),
+		(*SuscripcionMonitoreo_DesdeInstante)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_emergencia_v2_proto_rawDesc), len(file_emergencia_v2_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   27,
+			NumExtensions: 0,
+			NumServices:   3,
+		},
+		GoTypes:           file_emergencia_v2_proto_goTypes,
+		DependencyIndexes: file_emergencia_v2_proto_depIdxs,
+		EnumInfos:         file_emergencia_v2_proto_enumTypes,
+		MessageInfos:      file_emergencia_v2_proto_msgTypes,
+	}.Build()
+	File_emergencia_v2_proto = out.File
+	file_emergencia_v2_proto_goTypes = nil
+	file_emergencia_v2_proto_depIdxs = nil
+}
@@ -0,0 +1,709 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.12.4
+// source: emergencia_v2.proto
+
+// Versión 2 del protocolo de los servicios Asignador, Flota y Monitoreo. Los mismos
+// servidores atienden también la versión 1 (emergencia.proto) traduciéndola a esta con los
+// adaptadores de emergencia/v2, para que los clientes ya desplegados sigan funcionando
+// mientras migran. El servicio Dron sigue definido solo en la versión 1.
+
+package emergenciav2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Asignador_EnviarEmergencias_FullMethodName       = "/emergencia.v2.Asignador/EnviarEmergencias"
+	Asignador_EnviarEmergenciasStream_FullMethodName = "/emergencia.v2.Asignador/EnviarEmergenciasStream"
+	Asignador_ConsultarCola_FullMethodName           = "/emergencia.v2.Asignador/ConsultarCola"
+	Asignador_CancelarEmergencia_FullMethodName      = "/emergencia.v2.Asignador/CancelarEmergencia"
+	Asignador_ActualizarEmergencia_FullMethodName    = "/emergencia.v2.Asignador/ActualizarEmergencia"
+	Asignador_GetEmergencia_FullMethodName           = "/emergencia.v2.Asignador/GetEmergencia"
+	Asignador_ListEmergencias_FullMethodName         = "/emergencia.v2.Asignador/ListEmergencias"
+)
+
+// AsignadorClient is the client API for Asignador service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AsignadorClient interface {
+	EnviarEmergencias(ctx context.Context, in *EnviarEmergenciasRequest, opts ...grpc.CallOption) (*EnviarEmergenciasResponse, error)
+	EnviarEmergenciasStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Emergencia, AcuseEmergencia], error)
+	ConsultarCola(ctx context.Context, in *ConsultarColaRequest, opts ...grpc.CallOption) (*ConsultarColaResponse, error)
+	CancelarEmergencia(ctx context.Context, in *CancelarEmergenciaRequest, opts ...grpc.CallOption) (*CancelarEmergenciaResponse, error)
+	ActualizarEmergencia(ctx context.Context, in *ActualizarEmergenciaRequest, opts ...grpc.CallOption) (*ActualizarEmergenciaResponse, error)
+	GetEmergencia(ctx context.Context, in *GetEmergenciaRequest, opts ...grpc.CallOption) (*EmergenciaRegistrada, error)
+	ListEmergencias(ctx context.Context, in *ListEmergenciasRequest, opts ...grpc.CallOption) (*ListEmergenciasResponse, error)
+}
+
+type asignadorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAsignadorClient(cc grpc.ClientConnInterface) AsignadorClient {
+	return &asignadorClient{cc}
+}
+
+func (c *asignadorClient) EnviarEmergencias(ctx context.Context, in *EnviarEmergenciasRequest, opts ...grpc.CallOption) (*EnviarEmergenciasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnviarEmergenciasResponse)
+	err := c.cc.Invoke(ctx, Asignador_EnviarEmergencias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) EnviarEmergenciasStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Emergencia, AcuseEmergencia], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Asignador_ServiceDesc.Streams[0], Asignador_EnviarEmergenciasStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Emergencia, AcuseEmergencia]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Asignador_EnviarEmergenciasStreamClient = grpc.BidiStreamingClient[Emergencia, AcuseEmergencia]
+
+func (c *asignadorClient) ConsultarCola(ctx context.Context, in *ConsultarColaRequest, opts ...grpc.CallOption) (*ConsultarColaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsultarColaResponse)
+	err := c.cc.Invoke(ctx, Asignador_ConsultarCola_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) CancelarEmergencia(ctx context.Context, in *CancelarEmergenciaRequest, opts ...grpc.CallOption) (*CancelarEmergenciaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelarEmergenciaResponse)
+	err := c.cc.Invoke(ctx, Asignador_CancelarEmergencia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) ActualizarEmergencia(ctx context.Context, in *ActualizarEmergenciaRequest, opts ...grpc.CallOption) (*ActualizarEmergenciaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActualizarEmergenciaResponse)
+	err := c.cc.Invoke(ctx, Asignador_ActualizarEmergencia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) GetEmergencia(ctx context.Context, in *GetEmergenciaRequest, opts ...grpc.CallOption) (*EmergenciaRegistrada, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergenciaRegistrada)
+	err := c.cc.Invoke(ctx, Asignador_GetEmergencia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asignadorClient) ListEmergencias(ctx context.Context, in *ListEmergenciasRequest, opts ...grpc.CallOption) (*ListEmergenciasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmergenciasResponse)
+	err := c.cc.Invoke(ctx, Asignador_ListEmergencias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AsignadorServer is the server API for Asignador service.
+// All implementations must embed UnimplementedAsignadorServer
+// for forward compatibility.
+type AsignadorServer interface {
+	EnviarEmergencias(context.Context, *EnviarEmergenciasRequest) (*EnviarEmergenciasResponse, error)
+	EnviarEmergenciasStream(grpc.BidiStreamingServer[Emergencia, AcuseEmergencia]) error
+	ConsultarCola(context.Context, *ConsultarColaRequest) (*ConsultarColaResponse, error)
+	CancelarEmergencia(context.Context, *CancelarEmergenciaRequest) (*CancelarEmergenciaResponse, error)
+	ActualizarEmergencia(context.Context, *ActualizarEmergenciaRequest) (*ActualizarEmergenciaResponse, error)
+	GetEmergencia(context.Context, *GetEmergenciaRequest) (*EmergenciaRegistrada, error)
+	ListEmergencias(context.Context, *ListEmergenciasRequest) (*ListEmergenciasResponse, error)
+	mustEmbedUnimplementedAsignadorServer()
+}
+
+// UnimplementedAsignadorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAsignadorServer struct{}
+
+func (UnimplementedAsignadorServer) EnviarEmergencias(context.Context, *EnviarEmergenciasRequest) (*EnviarEmergenciasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnviarEmergencias not implemented")
+}
+func (UnimplementedAsignadorServer) EnviarEmergenciasStream(grpc.BidiStreamingServer[Emergencia, AcuseEmergencia]) error {
+	return status.Errorf(codes.Unimplemented, "method EnviarEmergenciasStream not implemented")
+}
+func (UnimplementedAsignadorServer) ConsultarCola(context.Context, *ConsultarColaRequest) (*ConsultarColaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsultarCola not implemented")
+}
+func (UnimplementedAsignadorServer) CancelarEmergencia(context.Context, *CancelarEmergenciaRequest) (*CancelarEmergenciaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelarEmergencia not implemented")
+}
+func (UnimplementedAsignadorServer) ActualizarEmergencia(context.Context, *ActualizarEmergenciaRequest) (*ActualizarEmergenciaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualizarEmergencia not implemented")
+}
+func (UnimplementedAsignadorServer) GetEmergencia(context.Context, *GetEmergenciaRequest) (*EmergenciaRegistrada, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmergencia not implemented")
+}
+func (UnimplementedAsignadorServer) ListEmergencias(context.Context, *ListEmergenciasRequest) (*ListEmergenciasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencias not implemented")
+}
+func (UnimplementedAsignadorServer) mustEmbedUnimplementedAsignadorServer() {}
+func (UnimplementedAsignadorServer) testEmbeddedByValue()                   {}
+
+// UnsafeAsignadorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AsignadorServer will
+// result in compilation errors.
+type UnsafeAsignadorServer interface {
+	mustEmbedUnimplementedAsignadorServer()
+}
+
+func RegisterAsignadorServer(s grpc.ServiceRegistrar, srv AsignadorServer) {
+	// If the following call pancis, it indicates UnimplementedAsignadorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Asignador_ServiceDesc, srv)
+}
+
+func _Asignador_EnviarEmergencias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnviarEmergenciasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).EnviarEmergencias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_EnviarEmergencias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).EnviarEmergencias(ctx, req.(*EnviarEmergenciasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_EnviarEmergenciasStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AsignadorServer).EnviarEmergenciasStream(&grpc.GenericServerStream[Emergencia, AcuseEmergencia]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Asignador_EnviarEmergenciasStreamServer = grpc.BidiStreamingServer[Emergencia, AcuseEmergencia]
+
+func _Asignador_ConsultarCola_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsultarColaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).ConsultarCola(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_ConsultarCola_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).ConsultarCola(ctx, req.(*ConsultarColaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_CancelarEmergencia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelarEmergenciaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).CancelarEmergencia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_CancelarEmergencia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).CancelarEmergencia(ctx, req.(*CancelarEmergenciaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_ActualizarEmergencia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualizarEmergenciaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).ActualizarEmergencia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_ActualizarEmergencia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).ActualizarEmergencia(ctx, req.(*ActualizarEmergenciaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_GetEmergencia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmergenciaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).GetEmergencia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_GetEmergencia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).GetEmergencia(ctx, req.(*GetEmergenciaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Asignador_ListEmergencias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergenciasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsignadorServer).ListEmergencias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Asignador_ListEmergencias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsignadorServer).ListEmergencias(ctx, req.(*ListEmergenciasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Asignador_ServiceDesc is the grpc.ServiceDesc for Asignador service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Asignador_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emergencia.v2.Asignador",
+	HandlerType: (*AsignadorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnviarEmergencias",
+			Handler:    _Asignador_EnviarEmergencias_Handler,
+		},
+		{
+			MethodName: "ConsultarCola",
+			Handler:    _Asignador_ConsultarCola_Handler,
+		},
+		{
+			MethodName: "CancelarEmergencia",
+			Handler:    _Asignador_CancelarEmergencia_Handler,
+		},
+		{
+			MethodName: "ActualizarEmergencia",
+			Handler:    _Asignador_ActualizarEmergencia_Handler,
+		},
+		{
+			MethodName: "GetEmergencia",
+			Handler:    _Asignador_GetEmergencia_Handler,
+		},
+		{
+			MethodName: "ListEmergencias",
+			Handler:    _Asignador_ListEmergencias_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EnviarEmergenciasStream",
+			Handler:       _Asignador_EnviarEmergenciasStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "emergencia_v2.proto",
+}
+
+const (
+	Flota_RegisterDron_FullMethodName       = "/emergencia.v2.Flota/RegisterDron"
+	Flota_DeregisterDron_FullMethodName     = "/emergencia.v2.Flota/DeregisterDron"
+	Flota_ListDrones_FullMethodName         = "/emergencia.v2.Flota/ListDrones"
+	Flota_GetDron_FullMethodName            = "/emergencia.v2.Flota/GetDron"
+	Flota_SetDronMaintenance_FullMethodName = "/emergencia.v2.Flota/SetDronMaintenance"
+)
+
+// FlotaClient is the client API for Flota service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FlotaClient interface {
+	RegisterDron(ctx context.Context, in *RegistrarDronRequest, opts ...grpc.CallOption) (*Dron, error)
+	DeregisterDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDrones(ctx context.Context, in *ListDronesRequest, opts ...grpc.CallOption) (*ListDronesResponse, error)
+	GetDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*Dron, error)
+	SetDronMaintenance(ctx context.Context, in *MantenimientoRequest, opts ...grpc.CallOption) (*Dron, error)
+}
+
+type flotaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFlotaClient(cc grpc.ClientConnInterface) FlotaClient {
+	return &flotaClient{cc}
+}
+
+func (c *flotaClient) RegisterDron(ctx context.Context, in *RegistrarDronRequest, opts ...grpc.CallOption) (*Dron, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Dron)
+	err := c.cc.Invoke(ctx, Flota_RegisterDron_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) DeregisterDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Flota_DeregisterDron_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) ListDrones(ctx context.Context, in *ListDronesRequest, opts ...grpc.CallOption) (*ListDronesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDronesResponse)
+	err := c.cc.Invoke(ctx, Flota_ListDrones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) GetDron(ctx context.Context, in *DronRequest, opts ...grpc.CallOption) (*Dron, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Dron)
+	err := c.cc.Invoke(ctx, Flota_GetDron_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flotaClient) SetDronMaintenance(ctx context.Context, in *MantenimientoRequest, opts ...grpc.CallOption) (*Dron, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Dron)
+	err := c.cc.Invoke(ctx, Flota_SetDronMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FlotaServer is the server API for Flota service.
+// All implementations must embed UnimplementedFlotaServer
+// for forward compatibility.
+type FlotaServer interface {
+	RegisterDron(context.Context, *RegistrarDronRequest) (*Dron, error)
+	DeregisterDron(context.Context, *DronRequest) (*emptypb.Empty, error)
+	ListDrones(context.Context, *ListDronesRequest) (*ListDronesResponse, error)
+	GetDron(context.Context, *DronRequest) (*Dron, error)
+	SetDronMaintenance(context.Context, *MantenimientoRequest) (*Dron, error)
+	mustEmbedUnimplementedFlotaServer()
+}
+
+// UnimplementedFlotaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFlotaServer struct{}
+
+func (UnimplementedFlotaServer) RegisterDron(context.Context, *RegistrarDronRequest) (*Dron, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDron not implemented")
+}
+func (UnimplementedFlotaServer) DeregisterDron(context.Context, *DronRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterDron not implemented")
+}
+func (UnimplementedFlotaServer) ListDrones(context.Context, *ListDronesRequest) (*ListDronesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrones not implemented")
+}
+func (UnimplementedFlotaServer) GetDron(context.Context, *DronRequest) (*Dron, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDron not implemented")
+}
+func (UnimplementedFlotaServer) SetDronMaintenance(context.Context, *MantenimientoRequest) (*Dron, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDronMaintenance not implemented")
+}
+func (UnimplementedFlotaServer) mustEmbedUnimplementedFlotaServer() {}
+func (UnimplementedFlotaServer) testEmbeddedByValue()               {}
+
+// UnsafeFlotaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FlotaServer will
+// result in compilation errors.
+type UnsafeFlotaServer interface {
+	mustEmbedUnimplementedFlotaServer()
+}
+
+func RegisterFlotaServer(s grpc.ServiceRegistrar, srv FlotaServer) {
+	// If the following call pancis, it indicates UnimplementedFlotaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Flota_ServiceDesc, srv)
+}
+
+func _Flota_RegisterDron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrarDronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).RegisterDron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_RegisterDron_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).RegisterDron(ctx, req.(*RegistrarDronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_DeregisterDron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).DeregisterDron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_DeregisterDron_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).DeregisterDron(ctx, req.(*DronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_ListDrones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDronesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).ListDrones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_ListDrones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).ListDrones(ctx, req.(*ListDronesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_GetDron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).GetDron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_GetDron_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).GetDron(ctx, req.(*DronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Flota_SetDronMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MantenimientoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlotaServer).SetDronMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Flota_SetDronMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlotaServer).SetDronMaintenance(ctx, req.(*MantenimientoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Flota_ServiceDesc is the grpc.ServiceDesc for Flota service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Flota_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emergencia.v2.Flota",
+	HandlerType: (*FlotaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDron",
+			Handler:    _Flota_RegisterDron_Handler,
+		},
+		{
+			MethodName: "DeregisterDron",
+			Handler:    _Flota_DeregisterDron_Handler,
+		},
+		{
+			MethodName: "ListDrones",
+			Handler:    _Flota_ListDrones_Handler,
+		},
+		{
+			MethodName: "GetDron",
+			Handler:    _Flota_GetDron_Handler,
+		},
+		{
+			MethodName: "SetDronMaintenance",
+			Handler:    _Flota_SetDronMaintenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "emergencia_v2.proto",
+}
+
+const (
+	Monitoreo_StreamMensajes_FullMethodName = "/emergencia.v2.Monitoreo/StreamMensajes"
+)
+
+// MonitoreoClient is the client API for Monitoreo service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MonitoreoClient interface {
+	StreamMensajes(ctx context.Context, in *SuscripcionMonitoreo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MensajeMonitoreo], error)
+}
+
+type monitoreoClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMonitoreoClient(cc grpc.ClientConnInterface) MonitoreoClient {
+	return &monitoreoClient{cc}
+}
+
+func (c *monitoreoClient) StreamMensajes(ctx context.Context, in *SuscripcionMonitoreo, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MensajeMonitoreo], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Monitoreo_ServiceDesc.Streams[0], Monitoreo_StreamMensajes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SuscripcionMonitoreo, MensajeMonitoreo]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitoreo_StreamMensajesClient = grpc.ServerStreamingClient[MensajeMonitoreo]
+
+// MonitoreoServer is the server API for Monitoreo service.
+// All implementations must embed UnimplementedMonitoreoServer
+// for forward compatibility.
+type MonitoreoServer interface {
+	StreamMensajes(*SuscripcionMonitoreo, grpc.ServerStreamingServer[MensajeMonitoreo]) error
+	mustEmbedUnimplementedMonitoreoServer()
+}
+
+// UnimplementedMonitoreoServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMonitoreoServer struct{}
+
+func (UnimplementedMonitoreoServer) StreamMensajes(*SuscripcionMonitoreo, grpc.ServerStreamingServer[MensajeMonitoreo]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMensajes not implemented")
+}
+func (UnimplementedMonitoreoServer) mustEmbedUnimplementedMonitoreoServer() {}
+func (UnimplementedMonitoreoServer) testEmbeddedByValue()                   {}
+
+// UnsafeMonitoreoServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MonitoreoServer will
+// result in compilation errors.
+type UnsafeMonitoreoServer interface {
+	mustEmbedUnimplementedMonitoreoServer()
+}
+
+func RegisterMonitoreoServer(s grpc.ServiceRegistrar, srv MonitoreoServer) {
+	// If the following call pancis, it indicates UnimplementedMonitoreoServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Monitoreo_ServiceDesc, srv)
+}
+
+func _Monitoreo_StreamMensajes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SuscripcionMonitoreo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MonitoreoServer).StreamMensajes(m, &grpc.GenericServerStream[SuscripcionMonitoreo, MensajeMonitoreo]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Monitoreo_StreamMensajesServer = grpc.ServerStreamingServer[MensajeMonitoreo]
+
+// Monitoreo_ServiceDesc is the grpc.ServiceDesc for Monitoreo service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Monitoreo_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emergencia.v2.Monitoreo",
+	HandlerType: (*MonitoreoServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMensajes",
+			Handler:       _Monitoreo_StreamMensajes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "emergencia_v2.proto",
+}
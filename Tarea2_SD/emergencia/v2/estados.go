@@ -0,0 +1,28 @@
+package emergenciav2
+
+import (
+	v1 "Tarea2_SD/emergencia"
+)
+
+// Los estados tienen los mismos valores que en la versión 1, así que el texto con que se
+// guardan en MongoDB se toma de ahí.
+
+// Texto devuelve el estado tal como se guarda en la colección emergencias.
+func (e EstadoEmergencia) Texto() string {
+	return v1.EstadoEmergencia(e).Texto()
+}
+
+// EstadoEmergenciaDesdeTexto interpreta el estado guardado en la colección emergencias.
+func EstadoEmergenciaDesdeTexto(texto string) EstadoEmergencia {
+	return EstadoEmergencia(v1.EstadoEmergenciaDesdeTexto(texto))
+}
+
+// Texto devuelve el estado tal como se guarda en la colección drones.
+func (e EstadoDron) Texto() string {
+	return v1.EstadoDron(e).Texto()
+}
+
+// EstadoDronDesdeTexto interpreta el estado guardado en la colección drones.
+func EstadoDronDesdeTexto(texto string) EstadoDron {
+	return EstadoDron(v1.EstadoDronDesdeTexto(texto))
+}
@@ -0,0 +1,15 @@
+package emergenciav2
+
+// Los eventos tienen los mismos campos que en la versión 1, así que el texto con que se
+// muestran se toma de ahí.
+
+// Texto describe el evento en una frase para mostrar en el monitoreo.
+func (e *EventoMonitoreo) Texto() string {
+	return e.aV1().Texto()
+}
+
+// Terminal indica si el evento cierra la emergencia, ya sea porque se extinguió o porque
+// fue cancelada.
+func (e *EventoMonitoreo) Terminal() bool {
+	return e.aV1().Terminal()
+}
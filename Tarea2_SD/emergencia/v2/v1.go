@@ -0,0 +1,323 @@
+package emergenciav2
+
+import (
+	"context"
+	"fmt"
+
+	v1 "Tarea2_SD/emergencia"
+)
+
+// Adaptadores que atienden los servicios Asignador, Flota y Monitoreo de la versión 1 con
+// una implementación de la versión 2: traducen cada petición v1 a su equivalente v2 y la
+// respuesta v2 de vuelta a v1, incluidos los textos que los clientes v1 muestran.
+
+// NuevoAsignadorV1 devuelve un servidor Asignador v1 que delega en srv.
+func NuevoAsignadorV1(srv AsignadorServer) v1.AsignadorServer {
+	return &asignadorV1{srv: srv}
+}
+
+// NuevaFlotaV1 devuelve un servidor Flota v1 que delega en srv.
+func NuevaFlotaV1(srv FlotaServer) v1.FlotaServer {
+	return &flotaV1{srv: srv}
+}
+
+// NuevoMonitoreoV1 devuelve un servidor Monitoreo v1 que delega en srv. Los suscriptores v1
+// no envían opciones, así que reciben en tiempo real y sin filtros los mensajes que lleguen
+// desde que se conectan.
+func NuevoMonitoreoV1(srv MonitoreoServer) v1.MonitoreoServer {
+	return &monitoreoV1{srv: srv}
+}
+
+type asignadorV1 struct {
+	v1.UnimplementedAsignadorServer
+	srv AsignadorServer
+}
+
+func (a *asignadorV1) EnviarEmergencias(ctx context.Context, req *v1.EmergenciasRequest) (*v1.Respuesta, error) {
+	nuevo := &EnviarEmergenciasRequest{}
+	for _, e := range req.Emergencias {
+		nuevo.Emergencias = append(nuevo.Emergencias, emergenciaDesdeV1(e))
+	}
+	resp, err := a.srv.EnviarEmergencias(ctx, nuevo)
+	if err != nil {
+		return nil, err
+	}
+
+	viejo := &v1.Respuesta{}
+	duplicadas := 0
+	for _, c := range resp.Encoladas {
+		if c.Duplicada {
+			duplicadas++
+		}
+		viejo.Encoladas = append(viejo.Encoladas, c.aV1())
+	}
+	viejo.Mensaje = fmt.Sprintf("%d emergencias encoladas, %d duplicadas", len(resp.Encoladas)-duplicadas, duplicadas)
+	return viejo, nil
+}
+
+func (a *asignadorV1) EnviarEmergenciasStream(stream v1.Asignador_EnviarEmergenciasStreamServer) error {
+	return a.srv.EnviarEmergenciasStream(streamV1{stream})
+}
+
+func (a *asignadorV1) ConsultarCola(ctx context.Context, _ *v1.Vacio) (*v1.EstadoCola, error) {
+	resp, err := a.srv.ConsultarCola(ctx, &ConsultarColaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	viejo := &v1.EstadoCola{}
+	for _, c := range resp.Emergencias {
+		viejo.Emergencias = append(viejo.Emergencias, c.aV1())
+	}
+	return viejo, nil
+}
+
+func (a *asignadorV1) CancelarEmergencia(ctx context.Context, req *v1.CancelarRequest) (*v1.Respuesta, error) {
+	resp, err := a.srv.CancelarEmergencia(ctx, &CancelarEmergenciaRequest{EmergencyId: req.EmergencyId, Motivo: req.Motivo})
+	if err != nil {
+		return nil, err
+	}
+	if resp.DronId != "" {
+		return &v1.Respuesta{Mensaje: fmt.Sprintf("Misión de %s abortada para la emergencia %d", resp.DronId, resp.EmergencyId)}, nil
+	}
+	return &v1.Respuesta{Mensaje: fmt.Sprintf("Emergencia %d retirada de la cola", resp.EmergencyId)}, nil
+}
+
+func (a *asignadorV1) GetEmergencia(ctx context.Context, req *v1.GetEmergenciaRequest) (*v1.EmergenciaRegistrada, error) {
+	resp, err := a.srv.GetEmergencia(ctx, &GetEmergenciaRequest{EmergencyId: req.EmergencyId})
+	if err != nil {
+		return nil, err
+	}
+	return resp.aV1(), nil
+}
+
+func (a *asignadorV1) ListEmergencias(ctx context.Context, req *v1.ListEmergenciasRequest) (*v1.ListEmergenciasResponse, error) {
+	nuevo := &ListEmergenciasRequest{
+		MagnitudMin:  req.MagnitudMin,
+		MagnitudMax:  req.MagnitudMax,
+		DronId:       req.DronId,
+		Desde:        req.Desde,
+		Hasta:        req.Hasta,
+		TamanoPagina: req.TamanoPagina,
+		TokenPagina:  req.TokenPagina,
+	}
+	if req.Estado != v1.EstadoEmergencia_EMERGENCIA_DESCONOCIDA {
+		nuevo.Estados = []EstadoEmergencia{EstadoEmergencia(req.Estado)}
+	} else if req.Status != "" {
+		nuevo.Estados = []EstadoEmergencia{EstadoEmergenciaDesdeTexto(req.Status)}
+	}
+	resp, err := a.srv.ListEmergencias(ctx, nuevo)
+	if err != nil {
+		return nil, err
+	}
+
+	viejo := &v1.ListEmergenciasResponse{SiguienteToken: resp.SiguienteToken, Total: resp.Total}
+	for _, e := range resp.Emergencias {
+		viejo.Emergencias = append(viejo.Emergencias, e.aV1())
+	}
+	return viejo, nil
+}
+
+// streamV1 presenta un stream EnviarEmergenciasStream v1 como uno v2.
+type streamV1 struct {
+	v1.Asignador_EnviarEmergenciasStreamServer
+}
+
+func (s streamV1) Recv() (*Emergencia, error) {
+	e, err := s.Asignador_EnviarEmergenciasStreamServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return emergenciaDesdeV1(e), nil
+}
+
+func (s streamV1) Send(a *AcuseEmergencia) error {
+	viejo := &v1.AcuseEmergencia{
+		Indice:      a.Indice,
+		EmergencyId: a.EmergencyId,
+		Posicion:    a.Posicion,
+		DronId:      a.DronId,
+		Duplicada:   a.Tipo == TipoAcuse_ACUSE_DUPLICADA,
+	}
+	switch a.Tipo {
+	case TipoAcuse_ACUSE_ENCOLADA:
+		viejo.Mensaje = "encolada"
+	case TipoAcuse_ACUSE_ASIGNADA:
+		viejo.Mensaje = "asignada a " + a.DronId
+	case TipoAcuse_ACUSE_CANCELADA:
+		viejo.Mensaje = "cancelada"
+	default:
+		viejo.Mensaje = a.Detalle
+	}
+	return s.Asignador_EnviarEmergenciasStreamServer.Send(viejo)
+}
+
+type flotaV1 struct {
+	v1.UnimplementedFlotaServer
+	srv FlotaServer
+}
+
+func (f *flotaV1) RegisterDron(ctx context.Context, req *v1.RegistrarDronRequest) (*v1.InfoDron, error) {
+	d, err := f.srv.RegisterDron(ctx, &RegistrarDronRequest{
+		Id:        req.Id,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Address:   req.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d.aV1(), nil
+}
+
+func (f *flotaV1) DeregisterDron(ctx context.Context, req *v1.DronRequest) (*v1.Respuesta, error) {
+	if _, err := f.srv.DeregisterDron(ctx, &DronRequest{Id: req.Id}); err != nil {
+		return nil, err
+	}
+	return &v1.Respuesta{Mensaje: fmt.Sprintf("Dron %s retirado de la flota", req.Id)}, nil
+}
+
+func (f *flotaV1) ListDrones(ctx context.Context, _ *v1.Vacio) (*v1.ListaDrones, error) {
+	resp, err := f.srv.ListDrones(ctx, &ListDronesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	lista := &v1.ListaDrones{}
+	for _, d := range resp.Drones {
+		lista.Drones = append(lista.Drones, d.aV1())
+	}
+	return lista, nil
+}
+
+func (f *flotaV1) GetDron(ctx context.Context, req *v1.DronRequest) (*v1.InfoDron, error) {
+	d, err := f.srv.GetDron(ctx, &DronRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return d.aV1(), nil
+}
+
+func (f *flotaV1) SetDronMaintenance(ctx context.Context, req *v1.MantenimientoRequest) (*v1.InfoDron, error) {
+	d, err := f.srv.SetDronMaintenance(ctx, &MantenimientoRequest{Id: req.Id, Mantenimiento: req.Mantenimiento})
+	if err != nil {
+		return nil, err
+	}
+	return d.aV1(), nil
+}
+
+type monitoreoV1 struct {
+	v1.UnimplementedMonitoreoServer
+	srv MonitoreoServer
+}
+
+func (m *monitoreoV1) StreamMensajes(_ *v1.Vacio, stream v1.Monitoreo_StreamMensajesServer) error {
+	return m.srv.StreamMensajes(&SuscripcionMonitoreo{}, mensajesV1{stream})
+}
+
+// mensajesV1 presenta un stream StreamMensajes v1 como uno v2.
+type mensajesV1 struct {
+	v1.Monitoreo_StreamMensajesServer
+}
+
+func (s mensajesV1) Send(m *MensajeMonitoreo) error {
+	return s.Monitoreo_StreamMensajesServer.Send(m.aV1())
+}
+
+func emergenciaDesdeV1(e *v1.Emergencia) *Emergencia {
+	return &Emergencia{
+		Name:              e.Name,
+		Latitude:          float64(e.Latitude),
+		Longitude:         float64(e.Longitude),
+		Magnitude:         e.Magnitude,
+		ClaveIdempotencia: e.ClaveIdempotencia,
+	}
+}
+
+func (c *EmergenciaEnCola) aV1() *v1.EmergenciaEnCola {
+	return &v1.EmergenciaEnCola{
+		EmergencyId:    c.EmergencyId,
+		Name:           c.Name,
+		Magnitude:      c.Magnitude,
+		Posicion:       c.Posicion,
+		EsperaSegundos: c.Espera.AsDuration().Seconds(),
+		Prioridad:      c.Prioridad,
+		Motivo:         c.Motivo,
+		DronId:         c.DronId,
+		Duplicada:      c.Duplicada,
+	}
+}
+
+func (e *EmergenciaRegistrada) aV1() *v1.EmergenciaRegistrada {
+	viejo := &v1.EmergenciaRegistrada{
+		EmergencyId:       e.EmergencyId,
+		Name:              e.Name,
+		Latitude:          float32(e.Latitude),
+		Longitude:         float32(e.Longitude),
+		Magnitude:         e.Magnitude,
+		Status:            e.Estado.Texto(),
+		Estado:            v1.EstadoEmergencia(e.Estado),
+		DronId:            e.DronId,
+		ClaveIdempotencia: e.ClaveIdempotencia,
+		MotivoCancelacion: e.MotivoCancelacion,
+		ReportedAt:        e.ReportedAt,
+		AssignedAt:        e.AssignedAt,
+		ArrivedAt:         e.ArrivedAt,
+		ExtinguishedAt:    e.ExtinguishedAt,
+		CancelledAt:       e.CancelledAt,
+	}
+	for _, i := range e.Intentos {
+		viejo.Intentos = append(viejo.Intentos, &v1.IntentoAsignacion{
+			DronId:    i.DronId,
+			Numero:    i.Numero,
+			Inicio:    i.Inicio,
+			Fin:       i.Fin,
+			Resultado: i.Resultado,
+			Error:     i.Error,
+		})
+	}
+	return viejo
+}
+
+func (d *Dron) aV1() *v1.InfoDron {
+	return &v1.InfoDron{
+		Id:            d.Id,
+		Latitude:      d.Latitude,
+		Longitude:     d.Longitude,
+		Estado:        v1.EstadoDron(d.Estado),
+		Address:       d.Address,
+		Mantenimiento: d.Mantenimiento,
+	}
+}
+
+func (m *MensajeMonitoreo) aV1() *v1.MensajeMonitoreo {
+	viejo := &v1.MensajeMonitoreo{
+		Contenido: m.Contenido,
+		Timestamp: m.Timestamp,
+		Evento:    m.Evento.aV1(),
+	}
+	if m.Salto != nil {
+		viejo.Salto = &v1.SaltoMonitoreo{Perdidos: m.Salto.Perdidos, Desde: m.Salto.Desde}
+	}
+	return viejo
+}
+
+func (e *EventoMonitoreo) aV1() *v1.EventoMonitoreo {
+	if e == nil {
+		return nil
+	}
+	return &v1.EventoMonitoreo{
+		Tipo:               v1.TipoEvento(e.Tipo),
+		EmergencyId:        e.EmergencyId,
+		DronId:             e.DronId,
+		Emergencia:         e.Emergencia,
+		Latitude:           e.Latitude,
+		Longitude:          e.Longitude,
+		Progreso:           e.Progreso,
+		Timestamp:          e.Timestamp,
+		ReportedAt:         e.ReportedAt,
+		AssignedAt:         e.AssignedAt,
+		ArrivedAt:          e.ArrivedAt,
+		ExtinguishedAt:     e.ExtinguishedAt,
+		EmergencyLatitude:  e.EmergencyLatitude,
+		EmergencyLongitude: e.EmergencyLongitude,
+	}
+}
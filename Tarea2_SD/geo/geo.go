@@ -0,0 +1,84 @@
+// Package geo reúne los cálculos geográficos compartidos por el asignador y el servicio de
+// drones: distancia y rumbo sobre la esfera terrestre, interpolación de posiciones y el
+// modelo de tiempos de una misión.
+package geo
+
+import (
+	"math"
+	"time"
+)
+
+// RadioTierraKm es el radio medio de la Tierra usado en los cálculos de distancia.
+const RadioTierraKm = 6371.0
+
+// VelocidadKmPorSegundo es la velocidad de crucero simulada de los drones.
+const VelocidadKmPorSegundo = 200.0
+
+// ApagadoPorMagnitud es el tiempo que tarda un dron en apagar cada punto de magnitud.
+const ApagadoPorMagnitud = 2 * time.Second
+
+func radianes(grados float64) float64 { return grados * math.Pi / 180 }
+
+func grados(radianes float64) float64 { return radianes * 180 / math.Pi }
+
+// Distancia devuelve la distancia en kilómetros entre dos puntos (latitud, longitud)
+// usando la fórmula del haversine.
+func Distancia(lat1, long1, lat2, long2 float64) float64 {
+	φ1, φ2 := radianes(lat1), radianes(lat2)
+	dφ := φ2 - φ1
+	dλ := radianes(long2 - long1)
+
+	a := math.Sin(dφ/2)*math.Sin(dφ/2) + math.Cos(φ1)*math.Cos(φ2)*math.Sin(dλ/2)*math.Sin(dλ/2)
+	return 2 * RadioTierraKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Rumbo devuelve el rumbo inicial en grados (0 = norte, sentido horario) para ir del
+// primer punto al segundo por el arco de círculo máximo.
+func Rumbo(lat1, long1, lat2, long2 float64) float64 {
+	φ1, φ2 := radianes(lat1), radianes(lat2)
+	dλ := radianes(long2 - long1)
+
+	y := math.Sin(dλ) * math.Cos(φ2)
+	x := math.Cos(φ1)*math.Sin(φ2) - math.Sin(φ1)*math.Cos(φ2)*math.Cos(dλ)
+	return math.Mod(grados(math.Atan2(y, x))+360, 360)
+}
+
+// Interpolar devuelve el punto que está a la fracción f (entre 0 y 1) del camino entre
+// los dos puntos, siguiendo el arco de círculo máximo.
+func Interpolar(lat1, long1, lat2, long2, f float64) (float64, float64) {
+	if f <= 0 {
+		return lat1, long1
+	}
+	if f >= 1 {
+		return lat2, long2
+	}
+	δ := Distancia(lat1, long1, lat2, long2) / RadioTierraKm
+	if δ == 0 {
+		return lat1, long1
+	}
+
+	φ1, λ1 := radianes(lat1), radianes(long1)
+	φ2, λ2 := radianes(lat2), radianes(long2)
+	a := math.Sin((1-f)*δ) / math.Sin(δ)
+	b := math.Sin(f*δ) / math.Sin(δ)
+	x := a*math.Cos(φ1)*math.Cos(λ1) + b*math.Cos(φ2)*math.Cos(λ2)
+	y := a*math.Cos(φ1)*math.Sin(λ1) + b*math.Cos(φ2)*math.Sin(λ2)
+	z := a*math.Sin(φ1) + b*math.Sin(φ2)
+	return grados(math.Atan2(z, math.Sqrt(x*x+y*y))), grados(math.Atan2(y, x))
+}
+
+// TiempoViaje estima cuánto tarda un dron en volar entre dos puntos a velocidad de crucero.
+func TiempoViaje(lat1, long1, lat2, long2 float64) time.Duration {
+	return time.Duration(Distancia(lat1, long1, lat2, long2) / VelocidadKmPorSegundo * float64(time.Second))
+}
+
+// TiempoApagado estima cuánto tarda un dron en extinguir una emergencia de la magnitud dada.
+func TiempoApagado(magnitud int32) time.Duration {
+	return time.Duration(magnitud) * ApagadoPorMagnitud
+}
+
+// DuracionMision estima la duración total de una misión: el viaje desde la posición del
+// dron hasta la emergencia más el tiempo de apagado.
+func DuracionMision(latDron, longDron, latEmergencia, longEmergencia float64, magnitud int32) time.Duration {
+	return TiempoViaje(latDron, longDron, latEmergencia, longEmergencia) + TiempoApagado(magnitud)
+}
@@ -0,0 +1,116 @@
+package geo
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// cerca indica si a y b difieren en menos de tolerancia.
+func cerca(a, b, tolerancia float64) bool { return math.Abs(a-b) < tolerancia }
+
+func TestDistancia(t *testing.T) {
+	// Un grado de arco sobre el ecuador o un meridiano mide 2πR/360 km
+	grado := 2 * math.Pi * RadioTierraKm / 360
+
+	for _, c := range []struct {
+		nombre                   string
+		lat1, long1, lat2, long2 float64
+		espera                   float64
+	}{
+		{"mismo punto", -33.45, -70.66, -33.45, -70.66, 0},
+		{"un grado sobre el ecuador", 0, 0, 0, 1, grado},
+		{"un grado sobre un meridiano", 10, -70, 11, -70, grado},
+		{"de polo a polo", 90, 0, -90, 0, math.Pi * RadioTierraKm},
+		{"antípodas sobre el ecuador", 0, 0, 0, 180, math.Pi * RadioTierraKm},
+		{"cruzando el antimeridiano", 0, 179.5, 0, -179.5, grado},
+	} {
+		if d := Distancia(c.lat1, c.long1, c.lat2, c.long2); !cerca(d, c.espera, 1e-6) {
+			t.Errorf("%s: Distancia = %f km, se esperaba %f km", c.nombre, d, c.espera)
+		}
+		if d := Distancia(c.lat2, c.long2, c.lat1, c.long1); !cerca(d, c.espera, 1e-6) {
+			t.Errorf("%s: Distancia en sentido inverso = %f km, se esperaba %f km", c.nombre, d, c.espera)
+		}
+	}
+}
+
+func TestRumbo(t *testing.T) {
+	for _, c := range []struct {
+		nombre                   string
+		lat1, long1, lat2, long2 float64
+		espera                   float64
+	}{
+		{"norte", 0, 0, 1, 0, 0},
+		{"este", 0, 0, 0, 1, 90},
+		{"sur", 0, 0, -1, 0, 180},
+		{"oeste", 0, 0, 0, -1, 270},
+		{"este cruzando el antimeridiano", 0, 179.5, 0, -179.5, 90},
+		// El círculo máximo entre dos puntos de latitud 45 sube hacia el polo, así que el
+		// rumbo inicial es atan(√2) y no 90
+		{"este desde latitud 45", 45, 0, 45, 90, 54.73561032},
+	} {
+		if r := Rumbo(c.lat1, c.long1, c.lat2, c.long2); !cerca(r, c.espera, 1e-6) {
+			t.Errorf("%s: Rumbo = %f°, se esperaba %f°", c.nombre, r, c.espera)
+		}
+	}
+}
+
+func TestInterpolar(t *testing.T) {
+	for _, c := range []struct {
+		nombre                   string
+		lat1, long1, lat2, long2 float64
+		f                        float64
+		lat, long                float64
+	}{
+		{"punto medio sobre el ecuador", 0, 0, 0, 90, 0.5, 0, 45},
+		{"punto medio sobre un meridiano", -10, -70, 30, -70, 0.5, 10, -70},
+		{"un cuarto del camino", 0, -20, 0, 20, 0.25, 0, -10},
+		{"punto medio cruzando el antimeridiano", 0, 170, 0, -170, 0.5, 0, 180},
+		{"fracción 0 devuelve el origen", -33.45, -70.66, -36.82, -73.05, 0, -33.45, -70.66},
+		{"fracción 1 devuelve el destino", -33.45, -70.66, -36.82, -73.05, 1, -36.82, -73.05},
+		{"fracción negativa se limita al origen", -33.45, -70.66, -36.82, -73.05, -0.5, -33.45, -70.66},
+		{"fracción mayor que 1 se limita al destino", -33.45, -70.66, -36.82, -73.05, 1.5, -36.82, -73.05},
+		// Con δ == 0 la fórmula dividiría por sin(δ) = 0
+		{"origen y destino iguales", -33.45, -70.66, -33.45, -70.66, 0.5, -33.45, -70.66},
+	} {
+		lat, long := Interpolar(c.lat1, c.long1, c.lat2, c.long2, c.f)
+		if math.IsNaN(lat) || math.IsNaN(long) {
+			t.Errorf("%s: Interpolar = (%f, %f)", c.nombre, lat, long)
+			continue
+		}
+		// ±180 son la misma longitud
+		if !cerca(lat, c.lat, 1e-9) || !cerca(math.Mod(long-c.long+540, 360), 180, 1e-9) {
+			t.Errorf("%s: Interpolar = (%f, %f), se esperaba (%f, %f)", c.nombre, lat, long, c.lat, c.long)
+		}
+	}
+}
+
+func TestInterpolarSigueElArco(t *testing.T) {
+	lat1, long1, lat2, long2 := -33.45, -70.66, -18.48, -70.31
+	total := Distancia(lat1, long1, lat2, long2)
+	for _, f := range []float64{0.1, 0.3, 0.5, 0.9} {
+		lat, long := Interpolar(lat1, long1, lat2, long2, f)
+		desdeOrigen := Distancia(lat1, long1, lat, long)
+		hastaDestino := Distancia(lat, long, lat2, long2)
+		if !cerca(desdeOrigen, f*total, 1e-6) || !cerca(hastaDestino, (1-f)*total, 1e-6) {
+			t.Errorf("f=%.1f: el punto queda a %f km del origen y %f km del destino, de un total de %f km", f, desdeOrigen, hastaDestino, total)
+		}
+	}
+}
+
+func TestTiempos(t *testing.T) {
+	grado := 2 * math.Pi * RadioTierraKm / 360
+	espera := time.Duration(grado / VelocidadKmPorSegundo * float64(time.Second))
+	if d := TiempoViaje(0, 0, 0, 1); d != espera {
+		t.Errorf("TiempoViaje = %s, se esperaba %s", d, espera)
+	}
+	if d := TiempoViaje(-33.45, -70.66, -33.45, -70.66); d != 0 {
+		t.Errorf("TiempoViaje al mismo punto = %s", d)
+	}
+	if d := TiempoApagado(5); d != 10*time.Second {
+		t.Errorf("TiempoApagado(5) = %s, se esperaba 10s", d)
+	}
+	if d := DuracionMision(0, 0, 0, 1, 5); d != espera+10*time.Second {
+		t.Errorf("DuracionMision = %s, se esperaba %s", d, espera+10*time.Second)
+	}
+}
@@ -0,0 +1,161 @@
+// Package monitoreo reúne la retención de mensajes del servicio de monitoreo: el buffer
+// circular numerado del que leen los suscriptores, los filtros de una suscripción y los
+// avisos de salto para quien se atrasa más de lo que el buffer retiene.
+package monitoreo
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	pbv2 "Tarea2_SD/emergencia/v2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// entrada es un mensaje retenido junto con el instante en que llegó.
+type entrada struct {
+	mensaje  *pbv2.MensajeMonitoreo
+	recibido time.Time
+}
+
+// Buffer retiene los mensajes más recientes en un buffer circular de tamaño fijo.
+//
+// Cada mensaje recibe un número de secuencia absoluto, que sigue creciendo aunque el
+// mensaje se descarte, de modo que un suscriptor puede saber cuántos mensajes perdió si se
+// atrasa más de lo que el buffer retiene. Como la numeración vuelve a empezar con cada
+// buffer, los mensajes llevan también la época del buffer, que lo distingue de los de
+// ejecuciones anteriores del servidor. Se descartan los mensajes más antiguos cuando el
+// buffer se llena o cuando superan la edad máxima.
+//
+// No es seguro para uso concurrente.
+type Buffer struct {
+	entradas   []entrada
+	edadMaxima time.Duration
+	epoca      string
+	// primero es la secuencia del mensaje retenido más antiguo
+	primero uint64
+	// siguiente es la secuencia que recibirá el próximo mensaje
+	siguiente uint64
+}
+
+// NuevoBuffer crea un buffer vacío para capacidad mensajes (al menos 1), con una época
+// aleatoria. Con edadMaxima 0 los mensajes solo se descartan cuando el buffer se llena.
+func NuevoBuffer(capacidad int, edadMaxima time.Duration) *Buffer {
+	var aleatorio [8]byte
+	rand.Read(aleatorio[:])
+	// La secuencia 0 queda sin usar, para que un cliente la distinga de un valor no indicado
+	return &Buffer{entradas: make([]entrada, max(capacidad, 1)), edadMaxima: edadMaxima, epoca: hex.EncodeToString(aleatorio[:]), primero: 1, siguiente: 1}
+}
+
+// Siguiente es la secuencia que recibirá el próximo mensaje.
+func (b *Buffer) Siguiente() uint64 { return b.siguiente }
+
+// Epoca identifica al buffer entre ejecuciones del servidor.
+func (b *Buffer) Epoca() string { return b.epoca }
+
+// Agregar asigna a mensaje la siguiente secuencia, la época del buffer y el instante de
+// llegada, y lo retiene, descartando el más antiguo si el buffer está lleno.
+func (b *Buffer) Agregar(mensaje *pbv2.MensajeMonitoreo, ahora time.Time) {
+	mensaje.Secuencia = b.siguiente
+	mensaje.Epoca = b.epoca
+	mensaje.Recibido = timestamppb.New(ahora)
+	b.entradas[b.siguiente%uint64(len(b.entradas))] = entrada{mensaje: mensaje, recibido: ahora}
+	b.siguiente++
+	if b.siguiente-b.primero > uint64(len(b.entradas)) {
+		b.primero = b.siguiente - uint64(len(b.entradas))
+	}
+	b.podar(ahora)
+}
+
+// podar descarta los mensajes que superan la edad máxima.
+func (b *Buffer) podar(ahora time.Time) {
+	if b.edadMaxima <= 0 {
+		return
+	}
+	for b.primero < b.siguiente {
+		i := b.primero % uint64(len(b.entradas))
+		if ahora.Sub(b.entradas[i].recibido) <= b.edadMaxima {
+			return
+		}
+		b.entradas[i] = entrada{}
+		b.primero++
+	}
+}
+
+// Inicio devuelve la secuencia desde la que empieza a leer una suscripción: la que pide,
+// el primer mensaje llegado desde el instante que pide, o si no pide ninguno, el final del
+// buffer.
+//
+// Si la suscripción pide una secuencia de otra época, empieza por el mensaje más antiguo
+// retenido y devuelve además el aviso de reinicio que debe recibir antes que nada. Retorna
+// OutOfRange si pide, sin época, una secuencia aún no asignada.
+func (b *Buffer) Inicio(sus *pbv2.SuscripcionMonitoreo, ahora time.Time) (uint64, *pbv2.MensajeMonitoreo, error) {
+	b.podar(ahora)
+	switch inicio := sus.GetInicio().(type) {
+	case *pbv2.SuscripcionMonitoreo_DesdeSecuencia:
+		if sus.Epoca != "" && sus.Epoca != b.epoca {
+			return b.primero, b.avisoReinicio(sus.Epoca, inicio.DesdeSecuencia), nil
+		}
+		if inicio.DesdeSecuencia > b.siguiente {
+			return 0, nil, status.Errorf(codes.OutOfRange, "la secuencia %d aún no existe (la próxima es %d); el servidor pudo haberse reiniciado", inicio.DesdeSecuencia, b.siguiente)
+		}
+		return max(inicio.DesdeSecuencia, 1), nil, nil
+	case *pbv2.SuscripcionMonitoreo_DesdeInstante:
+		// Los mensajes llegan en orden, así que sus instantes de llegada están ordenados
+		instante := inicio.DesdeInstante.AsTime()
+		n := int(b.siguiente - b.primero)
+		i := sort.Search(n, func(i int) bool {
+			return !b.entradas[(b.primero+uint64(i))%uint64(len(b.entradas))].recibido.Before(instante)
+		})
+		return b.primero + uint64(i), nil, nil
+	}
+	return b.siguiente, nil, nil
+}
+
+// Desde devuelve hasta limite mensajes retenidos a partir de la secuencia indicada que
+// cumplan el filtro, en orden de llegada. También retorna la secuencia desde la que
+// continuar la siguiente lectura y cuántos mensajes a partir de secuencia ya se
+// descartaron.
+func (b *Buffer) Desde(secuencia uint64, limite int, filtro *Filtro, ahora time.Time) ([]*pbv2.MensajeMonitoreo, uint64, uint64) {
+	b.podar(ahora)
+	var perdidos uint64
+	if secuencia < b.primero {
+		perdidos = b.primero - secuencia
+		secuencia = b.primero
+	}
+	var mensajes []*pbv2.MensajeMonitoreo
+	for ; secuencia < b.siguiente && len(mensajes) < limite; secuencia++ {
+		if msg := b.entradas[secuencia%uint64(len(b.entradas))].mensaje; filtro.Acepta(msg) {
+			mensajes = append(mensajes, msg)
+		}
+	}
+	return mensajes, secuencia, perdidos
+}
+
+// AvisoSalto arma el mensaje que informa a un suscriptor cuántos mensajes perdió, a partir
+// de la secuencia desde. Solo lee la época, que no cambia, así que no necesita que se
+// proteja el buffer.
+func (b *Buffer) AvisoSalto(desde, perdidos uint64) *pbv2.MensajeMonitoreo {
+	return &pbv2.MensajeMonitoreo{
+		Contenido: fmt.Sprintf("Se perdieron %d mensajes de monitoreo por atraso en la lectura", perdidos),
+		Timestamp: timestamppb.Now(),
+		Epoca:     b.epoca,
+		Salto:     &pbv2.SaltoMonitoreo{Perdidos: perdidos, Desde: desde},
+	}
+}
+
+// avisoReinicio arma el mensaje que informa a un suscriptor que pidió la secuencia desde de
+// la época anterior que el servidor se reinició y no se sabe cuántos mensajes perdió.
+func (b *Buffer) avisoReinicio(anterior string, desde uint64) *pbv2.MensajeMonitoreo {
+	return &pbv2.MensajeMonitoreo{
+		Contenido: fmt.Sprintf("El servicio de monitoreo se reinició (época %s, antes %s); se perdieron los mensajes no entregados", b.epoca, anterior),
+		Timestamp: timestamppb.Now(),
+		Epoca:     b.epoca,
+		Salto:     &pbv2.SaltoMonitoreo{Desde: desde, Reinicio: true},
+	}
+}
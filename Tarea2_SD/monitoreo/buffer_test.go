@@ -0,0 +1,179 @@
+package monitoreo
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	pbv2 "Tarea2_SD/emergencia/v2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var base = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// llenar agrega n mensajes al buffer, uno por segundo a partir de base
+func llenar(b *Buffer, n int) {
+	for i := 0; i < n; i++ {
+		b.Agregar(&pbv2.MensajeMonitoreo{}, base.Add(time.Duration(i)*time.Second))
+	}
+}
+
+func secuencias(mensajes []*pbv2.MensajeMonitoreo) []uint64 {
+	var s []uint64
+	for _, m := range mensajes {
+		s = append(s, m.Secuencia)
+	}
+	return s
+}
+
+func TestBufferDesde(t *testing.T) {
+	for _, c := range []struct {
+		nombre     string
+		capacidad  int
+		edadMaxima time.Duration
+		agregados  int
+		ahora      time.Duration // desde base
+		secuencia  uint64
+		limite     int
+		mensajes   []uint64
+		siguiente  uint64
+		perdidos   uint64
+	}{
+		{"vacío", 3, 0, 0, 0, 1, 10, nil, 1, 0},
+		{"sin llenarse", 3, 0, 2, 0, 1, 10, []uint64{1, 2}, 3, 0},
+		{"al día", 3, 0, 2, 0, 3, 10, nil, 3, 0},
+		{"vuelta completa", 3, 0, 5, 0, 3, 10, []uint64{3, 4, 5}, 6, 0},
+		{"vuelta con atraso", 3, 0, 5, 0, 1, 10, []uint64{3, 4, 5}, 6, 2},
+		{"varias vueltas", 3, 0, 10, 0, 2, 10, []uint64{8, 9, 10}, 11, 6},
+		{"límite", 3, 0, 5, 0, 3, 2, []uint64{3, 4}, 5, 0},
+		{"descarte por edad", 10, 3 * time.Second, 5, 6 * time.Second, 1, 10, []uint64{4, 5}, 6, 3},
+		{"todos vencidos", 10, 3 * time.Second, 5, time.Minute, 2, 10, nil, 6, 4},
+		{"edad y vuelta", 3, 3 * time.Second, 5, 5 * time.Second, 1, 10, []uint64{3, 4, 5}, 6, 2},
+	} {
+		t.Run(c.nombre, func(t *testing.T) {
+			b := NuevoBuffer(c.capacidad, c.edadMaxima)
+			llenar(b, c.agregados)
+			mensajes, siguiente, perdidos := b.Desde(c.secuencia, c.limite, nil, base.Add(c.ahora))
+			if got := secuencias(mensajes); !slices.Equal(got, c.mensajes) {
+				t.Errorf("mensajes = %v, se esperaba %v", got, c.mensajes)
+			}
+			if siguiente != c.siguiente || perdidos != c.perdidos {
+				t.Errorf("siguiente, perdidos = %d, %d, se esperaba %d, %d", siguiente, perdidos, c.siguiente, c.perdidos)
+			}
+		})
+	}
+}
+
+func TestBufferDesdeFiltrado(t *testing.T) {
+	b := NuevoBuffer(10, 0)
+	for i := int32(1); i <= 4; i++ {
+		b.Agregar(&pbv2.MensajeMonitoreo{Evento: &pbv2.EventoMonitoreo{EmergencyId: i % 2}}, base)
+	}
+	f, _ := NuevoFiltro(&pbv2.SuscripcionMonitoreo{EmergencyIds: []int32{1}})
+	mensajes, siguiente, _ := b.Desde(1, 10, f, base)
+	if got := secuencias(mensajes); !slices.Equal(got, []uint64{1, 3}) || siguiente != 5 {
+		t.Errorf("mensajes = %v hasta %d, se esperaba [1 3] hasta 5", got, siguiente)
+	}
+}
+
+func TestBufferInicio(t *testing.T) {
+	for _, c := range []struct {
+		nombre    string
+		sus       *pbv2.SuscripcionMonitoreo
+		secuencia uint64
+		codigo    codes.Code
+	}{
+		{"sin inicio", &pbv2.SuscripcionMonitoreo{}, 6, codes.OK},
+		{"secuencia retenida", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeSecuencia{DesdeSecuencia: 4}}, 4, codes.OK},
+		{"secuencia descartada", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeSecuencia{DesdeSecuencia: 1}}, 1, codes.OK},
+		{"secuencia cero", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeSecuencia{DesdeSecuencia: 0}}, 1, codes.OK},
+		{"secuencia siguiente", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeSecuencia{DesdeSecuencia: 6}}, 6, codes.OK},
+		{"secuencia futura", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeSecuencia{DesdeSecuencia: 7}}, 0, codes.OutOfRange},
+		{"instante retenido", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeInstante{DesdeInstante: timestamppb.New(base.Add(3 * time.Second))}}, 4, codes.OK},
+		{"instante entre mensajes", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeInstante{DesdeInstante: timestamppb.New(base.Add(2500 * time.Millisecond))}}, 4, codes.OK},
+		{"instante anterior", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeInstante{DesdeInstante: timestamppb.New(base.Add(-time.Hour))}}, 3, codes.OK},
+		{"instante posterior", &pbv2.SuscripcionMonitoreo{Inicio: &pbv2.SuscripcionMonitoreo_DesdeInstante{DesdeInstante: timestamppb.New(base.Add(time.Hour))}}, 6, codes.OK},
+	} {
+		t.Run(c.nombre, func(t *testing.T) {
+			// Capacidad 3 con 5 mensajes: se retienen las secuencias 3 a 5
+			b := NuevoBuffer(3, 0)
+			llenar(b, 5)
+			secuencia, aviso, err := b.Inicio(c.sus, base.Add(5*time.Second))
+			if status.Code(err) != c.codigo {
+				t.Fatalf("error = %v, se esperaba código %s", err, c.codigo)
+			}
+			if secuencia != c.secuencia || aviso != nil {
+				t.Errorf("secuencia = %d con aviso %v, se esperaba %d sin aviso", secuencia, aviso, c.secuencia)
+			}
+		})
+	}
+}
+
+func TestBufferEpoca(t *testing.T) {
+	b := NuevoBuffer(3, 0)
+	llenar(b, 5)
+	if otro := NuevoBuffer(3, 0); otro.Epoca() == b.Epoca() {
+		t.Fatalf("dos buffers con la misma época %q", b.Epoca())
+	}
+	mensajes, _, _ := b.Desde(3, 10, nil, base.Add(5*time.Second))
+	for _, m := range mensajes {
+		if m.Epoca != b.Epoca() || m.Recibido == nil {
+			t.Errorf("mensaje %d con época %q y recibido %v, se esperaba %q y el instante de llegada", m.Secuencia, m.Epoca, m.Recibido, b.Epoca())
+		}
+	}
+	if got, want := mensajes[0].Recibido.AsTime(), base.Add(2*time.Second); !got.Equal(want) {
+		t.Errorf("recibido = %v, se esperaba %v", got, want)
+	}
+
+	for _, c := range []struct {
+		nombre    string
+		epoca     string
+		desde     uint64
+		secuencia uint64
+		reinicio  bool
+		codigo    codes.Code
+	}{
+		{"misma época", b.Epoca(), 4, 4, false, codes.OK},
+		{"sin época", "", 4, 4, false, codes.OK},
+		{"sin época, secuencia futura", "", 9, 0, false, codes.OutOfRange},
+		// Tras un reinicio la secuencia pedida puede ser mayor o menor que las actuales
+		{"otra época, secuencia futura", "anterior", 9, 3, true, codes.OK},
+		{"otra época, secuencia retenida", "anterior", 4, 3, true, codes.OK},
+	} {
+		t.Run(c.nombre, func(t *testing.T) {
+			sus := &pbv2.SuscripcionMonitoreo{Epoca: c.epoca, Inicio: &pbv2.SuscripcionMonitoreo_DesdeSecuencia{DesdeSecuencia: c.desde}}
+			secuencia, aviso, err := b.Inicio(sus, base.Add(5*time.Second))
+			if status.Code(err) != c.codigo {
+				t.Fatalf("error = %v, se esperaba código %s", err, c.codigo)
+			}
+			if secuencia != c.secuencia {
+				t.Errorf("secuencia = %d, se esperaba %d", secuencia, c.secuencia)
+			}
+			if got := aviso.GetSalto().GetReinicio(); got != c.reinicio {
+				t.Fatalf("aviso de reinicio = %t, se esperaba %t", got, c.reinicio)
+			}
+			if c.reinicio && (aviso.Epoca != b.Epoca() || aviso.GetSalto().GetDesde() != c.desde) {
+				t.Errorf("aviso = %v, se esperaba época %q desde %d", aviso, b.Epoca(), c.desde)
+			}
+		})
+	}
+}
+
+func TestAvisoSalto(t *testing.T) {
+	b := NuevoBuffer(3, 0)
+	aviso := b.AvisoSalto(3, 7)
+	if aviso.GetSalto().GetDesde() != 3 || aviso.GetSalto().GetPerdidos() != 7 || aviso.GetSalto().GetReinicio() {
+		t.Errorf("salto = %v, se esperaba desde 3 y 7 perdidos, sin reinicio", aviso.GetSalto())
+	}
+	if aviso.Epoca != b.Epoca() {
+		t.Errorf("época = %q, se esperaba %q", aviso.Epoca, b.Epoca())
+	}
+	// Un filtro nunca retiene un aviso de salto, aunque no tenga evento
+	f, _ := NuevoFiltro(&pbv2.SuscripcionMonitoreo{Tipos: []pbv2.TipoEvento{pbv2.TipoEvento_EVENTO_EXTINGUIDA}})
+	if !f.Acepta(aviso) {
+		t.Error("el filtro descartó un aviso de salto")
+	}
+}
@@ -0,0 +1,79 @@
+package monitoreo
+
+import (
+	pbv2 "Tarea2_SD/emergencia/v2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Filtro selecciona los mensajes que recibe un suscriptor según los filtros de su
+// SuscripcionMonitoreo. Un filtro nil acepta todos los mensajes.
+type Filtro struct {
+	emergencias map[int32]bool
+	drones      map[string]bool
+	tipos       map[pbv2.TipoEvento]bool
+	region      *pbv2.RegionMonitoreo
+}
+
+// NuevoFiltro arma el filtro de una suscripción. Retorna nil si la suscripción no filtra,
+// o InvalidArgument si la región no es válida.
+func NuevoFiltro(sus *pbv2.SuscripcionMonitoreo) (*Filtro, error) {
+	if len(sus.GetEmergencyIds()) == 0 && len(sus.GetDronIds()) == 0 && len(sus.GetTipos()) == 0 && sus.GetRegion() == nil {
+		return nil, nil
+	}
+	if r := sus.GetRegion(); r != nil && (r.LatitudMin > r.LatitudMax || r.LongitudMin > r.LongitudMax) {
+		return nil, status.Errorf(codes.InvalidArgument, "región inválida: los mínimos deben ser menores o iguales que los máximos")
+	}
+
+	f := &Filtro{region: sus.GetRegion()}
+	if len(sus.GetEmergencyIds()) > 0 {
+		f.emergencias = make(map[int32]bool)
+		for _, id := range sus.GetEmergencyIds() {
+			f.emergencias[id] = true
+		}
+	}
+	if len(sus.GetDronIds()) > 0 {
+		f.drones = make(map[string]bool)
+		for _, id := range sus.GetDronIds() {
+			f.drones[id] = true
+		}
+	}
+	if len(sus.GetTipos()) > 0 {
+		f.tipos = make(map[pbv2.TipoEvento]bool)
+		for _, t := range sus.GetTipos() {
+			f.tipos[t] = true
+		}
+	}
+	return f, nil
+}
+
+// Acepta indica si msg cumple todos los filtros. Los avisos de salto siempre pasan; los
+// mensajes de texto libre, sin evento, solo pasan si no hay filtro. La región se compara
+// con la ubicación de la emergencia, no con la del dron, para que una suscripción reciba
+// todos los eventos de las emergencias de su zona, incluido el vuelo desde fuera de ella.
+func (f *Filtro) Acepta(msg *pbv2.MensajeMonitoreo) bool {
+	if f == nil || msg.Salto != nil {
+		return true
+	}
+	e := msg.Evento
+	if e == nil {
+		return false
+	}
+	if f.emergencias != nil && !f.emergencias[e.EmergencyId] {
+		return false
+	}
+	if f.drones != nil && !f.drones[e.DronId] {
+		return false
+	}
+	if f.tipos != nil && !f.tipos[e.Tipo] {
+		return false
+	}
+	if r := f.region; r != nil {
+		lat, long := e.EmergencyLatitude, e.EmergencyLongitude
+		if lat < r.LatitudMin || lat > r.LatitudMax || long < r.LongitudMin || long > r.LongitudMax {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package monitoreo
+
+import (
+	"testing"
+
+	pbv2 "Tarea2_SD/emergencia/v2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFiltroAcepta(t *testing.T) {
+	evento := &pbv2.MensajeMonitoreo{Evento: &pbv2.EventoMonitoreo{
+		Tipo:        pbv2.TipoEvento_EVENTO_EN_CAMINO,
+		EmergencyId: 3,
+		DronId:      "dron02",
+		// El dron aún vuela desde fuera de la región de la emergencia
+		Latitude:           -30.0,
+		Longitude:          -71.3,
+		EmergencyLatitude:  -33.4,
+		EmergencyLongitude: -70.6,
+	}}
+	texto := &pbv2.MensajeMonitoreo{Contenido: "texto libre"}
+	region := func(latMin, latMax, longMin, longMax float64) *pbv2.RegionMonitoreo {
+		return &pbv2.RegionMonitoreo{LatitudMin: latMin, LatitudMax: latMax, LongitudMin: longMin, LongitudMax: longMax}
+	}
+
+	for _, c := range []struct {
+		nombre string
+		sus    *pbv2.SuscripcionMonitoreo
+		msg    *pbv2.MensajeMonitoreo
+		acepta bool
+	}{
+		{"sin filtros", &pbv2.SuscripcionMonitoreo{}, evento, true},
+		{"sin filtros, texto libre", &pbv2.SuscripcionMonitoreo{}, texto, true},
+		{"filtro, texto libre", &pbv2.SuscripcionMonitoreo{EmergencyIds: []int32{3}}, texto, false},
+		{"emergencia incluida", &pbv2.SuscripcionMonitoreo{EmergencyIds: []int32{1, 3}}, evento, true},
+		{"emergencia excluida", &pbv2.SuscripcionMonitoreo{EmergencyIds: []int32{1}}, evento, false},
+		{"dron incluido", &pbv2.SuscripcionMonitoreo{DronIds: []string{"dron02"}}, evento, true},
+		{"dron excluido", &pbv2.SuscripcionMonitoreo{DronIds: []string{"dron01"}}, evento, false},
+		{"tipo incluido", &pbv2.SuscripcionMonitoreo{Tipos: []pbv2.TipoEvento{pbv2.TipoEvento_EVENTO_EN_CAMINO}}, evento, true},
+		{"tipo excluido", &pbv2.SuscripcionMonitoreo{Tipos: []pbv2.TipoEvento{pbv2.TipoEvento_EVENTO_EXTINGUIDA}}, evento, false},
+		{"dentro de la región", &pbv2.SuscripcionMonitoreo{Region: region(-34, -33, -71, -70)}, evento, true},
+		{"en el borde de la región", &pbv2.SuscripcionMonitoreo{Region: region(-33.4, -33, -70.6, -70)}, evento, true},
+		{"fuera de la región", &pbv2.SuscripcionMonitoreo{Region: region(-33, -32, -71, -70)}, evento, false},
+		{"solo el dron en la región", &pbv2.SuscripcionMonitoreo{Region: region(-31, -29, -72, -71)}, evento, false},
+		{"todos cumplen", &pbv2.SuscripcionMonitoreo{EmergencyIds: []int32{3}, DronIds: []string{"dron02"}, Region: region(-34, -33, -71, -70)}, evento, true},
+		{"uno no cumple", &pbv2.SuscripcionMonitoreo{EmergencyIds: []int32{3}, DronIds: []string{"dron01"}}, evento, false},
+	} {
+		t.Run(c.nombre, func(t *testing.T) {
+			f, err := NuevoFiltro(c.sus)
+			if err != nil {
+				t.Fatalf("NuevoFiltro: %v", err)
+			}
+			if got := f.Acepta(c.msg); got != c.acepta {
+				t.Errorf("Acepta = %t, se esperaba %t", got, c.acepta)
+			}
+		})
+	}
+}
+
+func TestNuevoFiltro(t *testing.T) {
+	if f, err := NuevoFiltro(&pbv2.SuscripcionMonitoreo{Modo: pbv2.ModoEntrega_ENTREGA_POR_LOTES}); f != nil || err != nil {
+		t.Errorf("sin filtros = %v, %v; se esperaba nil, nil", f, err)
+	}
+	_, err := NuevoFiltro(&pbv2.SuscripcionMonitoreo{Region: &pbv2.RegionMonitoreo{LatitudMin: 1, LatitudMax: 0}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("región invertida: error = %v, se esperaba InvalidArgument", err)
+	}
+}
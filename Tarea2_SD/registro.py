@@ -1,5 +1,6 @@
 import pika
 import json
+from datetime import datetime, timezone
 from pymongo import MongoClient
 
 # Configuración de credenciales para RabbitMQ
@@ -15,12 +16,18 @@ channel = connection.channel()
 
 channel.queue_declare(queue="registro_emergencias")
 channel.queue_declare(queue="apagar_emergencias")
+channel.queue_declare(queue="cancelar_emergencias")
 
 # Conexión a MongoDB (aquí también podrías necesitar credenciales)
 client = MongoClient("10.10.28.57", 27017)
 db = client.emergencias_db
 col = db.emergencias
 
+#    Convierte una marca de tiempo en milisegundos desde la época Unix, como la
+#    publican los servicios en Go, a datetime para guardarla como fecha en MongoDB.
+def desde_milisegundos(ms):
+    return datetime.fromtimestamp(ms / 1000, tz=timezone.utc)
+
 #    Callback para procesar mensajes de registro de emergencias.
 #    Parámetros:
 #        ch: Canal de RabbitMQ
@@ -45,14 +52,37 @@ def registrar_emergencia(ch, method, properties, body):
 #        body: Cuerpo del mensaje (bytes)        
 #    Acciones:
 #        1. Decodifica el mensaje JSON
-#        2. Actualiza el estado de la emergencia en MongoDB
+#        2. Actualiza el estado de la emergencia en MongoDB junto con las horas de
+#           llegada del dron y de extinción, si vienen en el mensaje
 def actualizar_estado(ch, method, properties, body):
     data = json.loads(body)
     emergency_id = data["emergency_id"]
-    col.update_one({"emergency_id": emergency_id}, {"$set": {"status": "Extinguido"}})
+    cambios = {"status": "Extinguido"}
+    for campo in ("arrived_at", "extinguished_at"):
+        if campo in data:
+            cambios[campo] = desde_milisegundos(data[campo])
+    col.update_one({"emergency_id": emergency_id}, {"$set": cambios})
+
+#    Callback para actualizar el estado de una emergencia a "Cancelada".
+#    Parámetros:
+#        ch: Canal de RabbitMQ
+#        method: Metadatos del mensaje
+#        properties: Propiedades del mensaje
+#        body: Cuerpo del mensaje (bytes)
+#    Acciones:
+#        1. Decodifica el mensaje JSON
+#        2. Marca la emergencia como cancelada en MongoDB junto con el motivo y la hora
+def cancelar_emergencia(ch, method, properties, body):
+    data = json.loads(body)
+    emergency_id = data["emergency_id"]
+    cambios = {"status": "Cancelada", "motivo_cancelacion": data.get("motivo", "")}
+    if "cancelled_at" in data:
+        cambios["cancelled_at"] = desde_milisegundos(data["cancelled_at"])
+    col.update_one({"emergency_id": emergency_id}, {"$set": cambios})
 
 channel.basic_consume(queue="registro_emergencias", on_message_callback=registrar_emergencia, auto_ack=True)
 channel.basic_consume(queue="apagar_emergencias", on_message_callback=actualizar_estado, auto_ack=True)
+channel.basic_consume(queue="cancelar_emergencias", on_message_callback=cancelar_emergencia, auto_ack=True)
 
 print("Servicio de registro escuchando...")
 channel.start_consuming()
\ No newline at end of file
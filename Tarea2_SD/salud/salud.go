@@ -0,0 +1,91 @@
+// Package salud expone en un servidor gRPC el servicio estándar grpc.health.v1 y la
+// reflexión del servidor, y mantiene el estado de salud a partir de chequeos periódicos de
+// las dependencias del servicio (MongoDB, RabbitMQ).
+//
+// Cada dependencia se publica como un servicio más de grpc.health.v1 con su propio nombre
+// (por ejemplo "mongodb"), mientras que el servidor completo ("") y cada servicio gRPC
+// registrado quedan SERVING solo si todas las dependencias responden.
+package salud
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/streadway/amqp"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Intervalo es cada cuánto se repiten los chequeos de dependencias.
+const Intervalo = 5 * time.Second
+
+// limiteChequeo es el tiempo máximo que puede tardar un chequeo antes de darlo por fallido.
+const limiteChequeo = 2 * time.Second
+
+// Chequeo verifica que una dependencia responde; retorna un error si no.
+type Chequeo func(ctx context.Context) error
+
+// Mongo devuelve un chequeo que hace ping al servidor de MongoDB.
+func Mongo(cliente *mongo.Client) Chequeo {
+	return func(ctx context.Context) error {
+		return cliente.Ping(ctx, nil)
+	}
+}
+
+// RabbitMQ devuelve un chequeo que falla si la conexión con RabbitMQ se cerró.
+func RabbitMQ(conn *amqp.Connection) Chequeo {
+	return func(ctx context.Context) error {
+		if conn.IsClosed() {
+			return amqp.ErrClosed
+		}
+		return nil
+	}
+}
+
+// Registrar agrega los servicios de salud y reflexión a srv y lanza una goroutine que
+// actualiza el estado según los chequeos. Debe llamarse después de registrar los servicios
+// propios del servidor y antes de Serve.
+func Registrar(srv *grpc.Server, chequeos map[string]Chequeo) {
+	var servicios []string
+	for nombre := range srv.GetServiceInfo() {
+		servicios = append(servicios, nombre)
+	}
+
+	h := health.NewServer()
+	healthpb.RegisterHealthServer(srv, h)
+	reflection.Register(srv)
+
+	actualizar(h, servicios, chequeos)
+	go func() {
+		for range time.Tick(Intervalo) {
+			actualizar(h, servicios, chequeos)
+		}
+	}()
+}
+
+// actualizar ejecuta los chequeos y fija el estado de cada dependencia y de los servicios.
+func actualizar(h *health.Server, servicios []string, chequeos map[string]Chequeo) {
+	general := healthpb.HealthCheckResponse_SERVING
+	for nombre, chequeo := range chequeos {
+		ctx, cancel := context.WithTimeout(context.Background(), limiteChequeo)
+		err := chequeo(ctx)
+		cancel()
+
+		estado := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			estado = healthpb.HealthCheckResponse_NOT_SERVING
+			general = healthpb.HealthCheckResponse_NOT_SERVING
+			log.Printf("Dependencia %s no disponible: %v", nombre, err)
+		}
+		h.SetServingStatus(nombre, estado)
+	}
+
+	h.SetServingStatus("", general)
+	for _, servicio := range servicios {
+		h.SetServingStatus(servicio, general)
+	}
+}